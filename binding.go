@@ -1,26 +1,53 @@
 package genjector
 
-import "fmt"
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
 
 // bindingSource is a concrete implementation for BindingSource interface.
 type bindingSource[T any] struct {
 	binding   Binding
 	keySource baseKeySource[T]
+	static    reflect.Type
 }
 
 // Binding returns containing instance of Binding interface. Initially it makes
 // the concrete instance, to check if instance matches desired type of Binding.
+// In case the type of the instance is known upfront, like for ContextProviderMethod,
+// it only checks if that type can be assigned to type T, without making the instance.
 //
 // It respects BindingSource interface.
 func (s *bindingSource[T]) Binding() (Binding, error) {
-	instance, err := s.binding.Instance(false)
+	if s.static != nil {
+		expected := reflect.TypeOf((*T)(nil)).Elem()
+		if !s.static.AssignableTo(expected) {
+			return nil, &TypeMismatchError{
+				Key:      s.Key(),
+				Expected: expected,
+				Actual:   s.static,
+			}
+		}
+		return s.binding, nil
+	}
+
+	ctx := &resolutionContext{
+		Context: context.Background(),
+		resolution: resolution{
+			key: s.Key(),
+		},
+	}
+
+	instance, err := s.binding.Instance(ctx, false)
 	if err != nil {
 		return nil, err
 	}
 
 	if _, ok := instance.(T); !ok {
-		var initial T
-		return nil, fmt.Errorf(`binding is not possible for "%v" and "%v"`, initial, instance)
+		return nil, newTypeMismatchError[T](s.Key(), instance)
 	}
 	return s.binding, nil
 }
@@ -35,32 +62,131 @@ func (s *bindingSource[T]) Key() Key {
 // Initializable represents any struct that contains a method Init.
 // When such struct as defined AsPointer or AsValue, method Init will be
 // called during initialization process.
+//
+// NewInstance method executed inside Init method does not know about the outer
+// instance, so dependency cycles through such Init methods are detected only when
+// they pass through a singleton. For all other Binding instances, they are still
+// fatal, as they never end. InitializableWithContext should be used instead.
 type Initializable interface {
 	Init()
 }
 
+// InitializableWithError represents any struct that contains a method Init,
+// which can fail. When such struct as defined AsPointer or AsValue, method Init
+// will be called during initialization process, and its error will be returned
+// from NewInstance method.
+//
+// In the same way as for Initializable, dependency cycles through such Init methods
+// are detected only when they pass through a singleton, so InitializableWithContext
+// should be used instead.
+type InitializableWithError interface {
+	Init() error
+}
+
+// InitializableWithContext represents any struct that contains a method Init,
+// which uses the context.Context and can fail. When such struct as defined AsPointer
+// or AsValue, method Init will be called during initialization process, and its error
+// will be returned from NewInstance method.
+//
+// The context.Context should be used for all calls of NewInstanceContext method
+// inside Init method, so all dependency cycles can be detected.
+type InitializableWithContext interface {
+	Init(ctx context.Context) error
+}
+
+// initializeInstance executes Init method of the instance, in case it respects
+// Initializable, InitializableWithError or InitializableWithContext interface.
+func initializeInstance(ctx context.Context, instance interface{}) error {
+	var err error
+	switch value := instance.(type) {
+	case Initializable:
+		value.Init()
+	case InitializableWithError:
+		err = value.Init()
+	case InitializableWithContext:
+		err = value.Init(ctx)
+	}
+
+	if err != nil {
+		return newProviderError(ctx, err)
+	}
+
+	return nil
+}
+
+// initializeDirect executes Init method of the instance, in case it respects
+// Initializable or InitializableWithError interface. In case it respects
+// InitializableWithContext interface, it reports false without executing
+// Init method, as the resolution is required for it.
+func initializeDirect(key Key, instance interface{}) (bool, error) {
+	var err error
+	switch value := instance.(type) {
+	case Initializable:
+		value.Init()
+	case InitializableWithError:
+		err = value.Init()
+	case InitializableWithContext:
+		return false, nil
+	}
+
+	if err != nil {
+		return true, newKeyProviderError(key, err)
+	}
+
+	return true, nil
+}
+
+// isInitializable checks if the instance respects Initializable,
+// InitializableWithError or InitializableWithContext interface.
+func isInitializable(instance interface{}) bool {
+	switch instance.(type) {
+	case Initializable, InitializableWithError, InitializableWithContext:
+		return true
+	}
+	return false
+}
+
 // valueBinding is a concrete implementation for Binding interface.
 type valueBinding[S any] struct{}
 
 // Instance delivers the value of the concrete instance of type S.
-// If the pointer to the struct respects Initializable interface,
-// Init method will be called.
+// If the pointer to the struct respects Initializable, InitializableWithError
+// or InitializableWithContext interface, Init method will be called.
 //
 // It respects Binding interface.
-func (valueBinding[S]) Instance(initialize bool) (interface{}, error) {
+func (valueBinding[S]) Instance(ctx context.Context, initialize bool) (interface{}, error) {
 	initial := *new(S)
 	var instance interface{} = &initial
 	if !initialize {
 		return initial, nil
 	}
 
-	if value, ok := instance.(Initializable); ok {
-		value.Init()
+	err := initializeInstance(ctx, instance)
+	if err != nil {
+		return nil, err
 	}
 
 	return initial, nil
 }
 
+// direct delivers the value of the concrete instance of type S, in case
+// the pointer to the struct does not have Init method with context.Context.
+//
+// It respects directBinding interface.
+func (valueBinding[S]) direct(key Key) (interface{}, bool, error) {
+	if !isInitializable((*S)(nil)) {
+		return *new(S), true, nil
+	}
+
+	initial := *new(S)
+	ok, err := initializeDirect(key, &initial)
+	if !ok || err != nil {
+		return nil, ok, err
+	}
+
+	return initial, true, nil
+}
+
 // AsValue delivers a BindingSource for a type T, by binding a value of a struct
 // to the concrete interface (or the struct itself). It must be only used with value and
 // not pointer. In case pointer is used, code will return a nil value for the instance.
@@ -80,22 +206,38 @@ func AsValue[T any, S any]() BindingSource[T] {
 type pointerBinding[R any] struct{}
 
 // Instance delivers the pointer of the concrete instance of type S.
-// If the struct respects Initializable interface, Init method will be called.
+// If the struct respects Initializable, InitializableWithError or
+// InitializableWithContext interface, Init method will be called.
 //
 // It respects Binding interface.
-func (pointerBinding[R]) Instance(initialize bool) (interface{}, error) {
+func (pointerBinding[R]) Instance(ctx context.Context, initialize bool) (interface{}, error) {
 	var instance interface{} = new(R)
 	if !initialize {
 		return instance, nil
 	}
 
-	if value, ok := instance.(Initializable); ok {
-		value.Init()
+	err := initializeInstance(ctx, instance)
+	if err != nil {
+		return nil, err
 	}
 
 	return instance, nil
 }
 
+// direct delivers the pointer of the concrete instance of type S, in case
+// the struct does not have Init method with context.Context.
+//
+// It respects directBinding interface.
+func (pointerBinding[R]) direct(key Key) (interface{}, bool, error) {
+	var instance interface{} = new(R)
+	ok, err := initializeDirect(key, instance)
+	if !ok || err != nil {
+		return nil, ok, err
+	}
+
+	return instance, true, nil
+}
+
 // AsPointer delivers a BindingSource for a type T, by binding pointer of a struct
 // to the concrete interface (or the struct itself). It must be only used with pointers and
 // not values. In case values is used, code will panic.
@@ -119,11 +261,27 @@ func AsPointer[T any, S *R, R any]() BindingSource[T] {
 type ProviderMethod[S any] func() (S, error)
 
 // Instance delivers the concrete instance of type S, by executing
-// root ProviderMethod itself.
+// root ProviderMethod itself. Its error is wrapped inside ProviderError.
 //
 // It respects Binding interface.
-func (s ProviderMethod[S]) Instance(bool) (interface{}, error) {
-	return s()
+func (s ProviderMethod[S]) Instance(ctx context.Context, _ bool) (interface{}, error) {
+	instance, err := s()
+	if err != nil {
+		return nil, newProviderError(ctx, err)
+	}
+	return instance, nil
+}
+
+// direct delivers the concrete instance of type S, by executing root
+// ProviderMethod itself, as it does not use the context.Context.
+//
+// It respects directBinding interface.
+func (s ProviderMethod[S]) direct(key Key) (interface{}, bool, error) {
+	instance, err := s()
+	if err != nil {
+		return nil, true, newKeyProviderError(key, err)
+	}
+	return instance, true, nil
 }
 
 // AsProvider delivers a BindingSource for a type T, by defining a ProviderMethod
@@ -145,6 +303,54 @@ func AsProvider[T any, S any](provider ProviderMethod[S]) BindingSource[T] {
 	}
 }
 
+// ContextProviderMethod defines a type of a method that should delivers
+// an instance od type S, by using the context.Context provided to
+// NewInstanceContext method. This method acts as an constructor method
+// and it is executed only at the time of NewInstanceContext method.
+//
+// It respects Binding interface.
+type ContextProviderMethod[S any] func(ctx context.Context) (S, error)
+
+// Instance delivers the concrete instance of type S, by executing
+// root ContextProviderMethod itself. In case initialization is not
+// required, it delivers an empty value of type S, without executing
+// ContextProviderMethod. Its error is wrapped inside ProviderError.
+//
+// It respects Binding interface.
+func (s ContextProviderMethod[S]) Instance(ctx context.Context, initialize bool) (interface{}, error) {
+	if !initialize {
+		return *new(S), nil
+	}
+
+	instance, err := s(ctx)
+	if err != nil {
+		return nil, newProviderError(ctx, err)
+	}
+	return instance, nil
+}
+
+// AsContextProvider delivers a BindingSource for a type T, by defining a ContextProviderMethod
+// (or constructor method) for the new instance of some interface (or a struct).
+// The context.Context passed to ContextProviderMethod should be used for all inner
+// calls of NewInstanceContext method.
+//
+// Example:
+//
+//	err := genjector.Bind(genjector.AsContextProvider[ProviderInterface](func(ctx context.Context) (*ProviderStruct, error) {
+//	  return &ProviderStruct{
+//	    value: ctx.Value(valueKey{}).(string),
+//	  }, nil
+//	}))
+//
+// BindingSource can be only used as the first argument to Bind method.
+func AsContextProvider[T any, S any](provider ContextProviderMethod[S]) BindingSource[T] {
+	return &bindingSource[T]{
+		binding:   provider,
+		keySource: baseKeySource[T]{},
+		static:    reflect.TypeOf((*S)(nil)).Elem(),
+	}
+}
+
 // instanceBinding is a concrete implementation for Binding interface.
 type instanceBinding[S any] struct {
 	instance S
@@ -154,10 +360,24 @@ type instanceBinding[S any] struct {
 // initialized instance that instanceBinding holds.
 //
 // It respects Binding interface.
-func (s *instanceBinding[S]) Instance(bool) (interface{}, error) {
+func (s *instanceBinding[S]) Instance(context.Context, bool) (interface{}, error) {
 	return s.instance, nil
 }
 
+// direct delivers already initialized instance that instanceBinding holds.
+//
+// It respects directBinding interface.
+func (s *instanceBinding[S]) direct(Key) (interface{}, bool, error) {
+	return s.instance, true, nil
+}
+
+// lifetime delivers LifetimeInstance.
+//
+// It respects lifetimeBinding interface.
+func (*instanceBinding[S]) lifetime() Lifetime {
+	return LifetimeInstance
+}
+
 // AsInstance delivers a BindingSource for a type T, by using a concrete
 // instance that is passed as an argument to AsInstance method, to returns
 // that instance whenever it is required from Binding.
@@ -201,35 +421,150 @@ func (b *bindingOption) Key(key Key) Key {
 // Container executes the same method from inner KeyOption instance.
 //
 // It respects BindingOption interface.
-func (b *bindingOption) Container(container Container) Container {
+func (b *bindingOption) Container(container *Container) *Container {
 	return b.keyOption.Container(container)
 }
 
+// singletonCall holds the result of a single construction of
+// the instance inside singletonBinding.
+type singletonCall struct {
+	done     chan struct{}
+	instance interface{}
+	err      error
+}
+
 // singletonBinding is a concrete implementation for Binding interface.
 type singletonBinding struct {
-	parent      Binding
-	singleton   interface{}
-	initialized bool
+	parent    Binding
+	disposer  *disposer
+	eager     bool
+	singleton atomic.Pointer[singletonCall]
+	mutex     sync.Mutex
+	call      *singletonCall
 }
 
 // Instance delivers already stored instance, which should be present if this
 // method was already executed before. Otherwise it retrieves the instance from
 // a child Binding and stores it internally for the next calls.
 //
+// Concurrent calls during the first construction wait for that construction
+// to finish, so the child Binding is executed only once and all callers
+// receive the same instance. In case of the error, all waiting callers
+// receive the same error, and the next call tries the construction again.
+//
+// During validation of the Container, the instance is made without storing it,
+// and it is released at the end of validation.
+//
+// During the first construction, its resolution is linked to the goroutine,
+// so in case the same goroutine requires the instance again, even without
+// the context.Context, like Init method that executes NewInstance method for
+// a type that depends on the singleton, NewInstance method returns CycleError
+// instead of waiting.
+//
 // It respects Binding interface.
-func (b *singletonBinding) Instance(initialize bool) (interface{}, error) {
-	if b.initialized {
-		return b.singleton, nil
+func (b *singletonBinding) Instance(ctx context.Context, initialize bool) (interface{}, error) {
+	if singleton := b.singleton.Load(); singleton != nil {
+		return singleton.instance, nil
 	}
 
-	instance, err := b.parent.Instance(initialize)
-	if err != nil {
-		return nil, err
+	if !initialize {
+		return b.parent.Instance(ctx, initialize)
 	}
 
-	b.initialized = true
-	b.singleton = instance
-	return instance, nil
+	if validation := resolutionFromContext(ctx).validation; validation != nil {
+		instance, err := b.parent.Instance(ctx, initialize)
+		if err == nil {
+			validation.track(instance)
+		}
+		return instance, err
+	}
+
+	b.mutex.Lock()
+	if singleton := b.singleton.Load(); singleton != nil {
+		b.mutex.Unlock()
+		return singleton.instance, nil
+	}
+
+	call := b.call
+	if call != nil {
+		b.mutex.Unlock()
+
+		<-call.done
+		return call.instance, call.err
+	}
+
+	call = &singletonCall{
+		done: make(chan struct{}),
+	}
+	b.call = call
+	b.mutex.Unlock()
+
+	b.construct(ctx, call)
+	return call.instance, call.err
+}
+
+// direct delivers already stored instance, in case it is already constructed.
+//
+// It respects directBinding interface.
+func (b *singletonBinding) direct(Key) (interface{}, bool, error) {
+	if singleton := b.singleton.Load(); singleton != nil {
+		return singleton.instance, true, nil
+	}
+	return nil, false, nil
+}
+
+// construct executes a child Binding and stores its result. It always
+// releases waiting callers, even in case child Binding panics.
+func (b *singletonBinding) construct(ctx context.Context, call *singletonCall) {
+	trace := linkTrace(resolutionFromContext(ctx))
+	defer func() {
+		trace.leave()
+
+		b.mutex.Lock()
+		if call.err == nil {
+			b.singleton.Store(call)
+		}
+		b.call = nil
+		b.mutex.Unlock()
+
+		close(call.done)
+	}()
+
+	// waiting callers receive this error in case child Binding panics
+	call.err = errors.New("singleton construction panicked")
+	call.instance, call.err = b.parent.Instance(ctx, true)
+	if call.err == nil {
+		b.track(ctx, call.instance)
+	}
+}
+
+// track stores the instance for releasing it later. By default, instance is
+// stored inside the Container where singletonBinding is defined.
+func (b *singletonBinding) track(ctx context.Context, instance interface{}) {
+	disposer := b.disposer
+	if disposer == nil {
+		resolution, ok := ctx.Value(resolutionKey{}).(*resolution)
+		if !ok || resolution.container == nil {
+			return
+		}
+		disposer = &resolution.container.disposer
+	}
+
+	disposer.track(instance)
+}
+
+// unwrap delivers the child Binding.
+//
+// It respects wrappingBinding interface.
+func (b *singletonBinding) unwrap() Binding {
+	return b.parent
+}
+
+// lifetime delivers LifetimeSingleton.
+//
+// It respects lifetimeBinding interface.
+func (*singletonBinding) lifetime() Lifetime {
+	return LifetimeSingleton
 }
 
 // AsSingleton delivers a BindingOption that defines the instance of desired
@@ -237,6 +572,9 @@ func (b *singletonBinding) Instance(initialize bool) (interface{}, error) {
 // will be called, and every next time the same instance will be delivered
 // as a result of NewInstance method.
 //
+// In case the singleton respects Disposable or io.Closer interface, it is
+// released by the Close method of the Container where it is defined.
+//
 // Example:
 // err := genjector.Bind(
 //
@@ -296,7 +634,7 @@ func WithAnnotation(annotation string) BindingOption {
 //
 // To properly use a customer Container, WithContainer should be used in both
 // Bind and NewInstance methods.
-func WithContainer(container Container) BindingOption {
+func WithContainer(container *Container) BindingOption {
 	return &bindingOption{
 		bindingFunc: func(binding Binding) (Binding, error) {
 			return binding, nil
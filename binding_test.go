@@ -1,9 +1,15 @@
 package genjector
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"reflect"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"testing/synctest"
+	"time"
 )
 
 type testBinding struct {
@@ -11,20 +17,20 @@ type testBinding struct {
 	instance func(initialize bool) (interface{}, error)
 }
 
-func (b *testBinding) Instance(initialize bool) (interface{}, error) {
+func (b *testBinding) Instance(_ context.Context, initialize bool) (interface{}, error) {
 	return b.instance(initialize)
 }
 
 type testKeyOption struct {
 	key       func(key Key) Key
-	container func(container Container) Container
+	container func(container *Container) *Container
 }
 
 func (o *testKeyOption) Key(key Key) Key {
 	return o.key(key)
 }
 
-func (o *testKeyOption) Container(container Container) Container {
+func (o *testKeyOption) Container(container *Container) *Container {
 	return o.container(container)
 }
 
@@ -38,6 +44,30 @@ func (s *testStruct) Init() {
 	s.b = 10
 }
 
+type testErrorStruct struct {
+	fail bool
+	done bool
+}
+
+var errTestInit = errors.New("init error")
+
+func (s *testErrorStruct) Init() error {
+	if s.fail {
+		return errTestInit
+	}
+	s.done = true
+	return nil
+}
+
+type testFailingStruct struct {
+	testErrorStruct
+}
+
+func (s *testFailingStruct) Init() error {
+	s.fail = true
+	return s.testErrorStruct.Init()
+}
+
 var singleton = 1
 
 type testSingletonStruct struct{}
@@ -122,7 +152,7 @@ func Test_bindingSource_Key(t *testing.T) {
 
 func Test_valueBinding_Instance_noInitialize(t *testing.T) {
 	stringBinding := &valueBinding[string]{}
-	result, err := stringBinding.Instance(false)
+	result, err := stringBinding.Instance(context.Background(), false)
 	if err != nil {
 		t.Errorf(`expected nil, got: %v`, err)
 	}
@@ -131,7 +161,7 @@ func Test_valueBinding_Instance_noInitialize(t *testing.T) {
 	}
 
 	intBinding := &valueBinding[int]{}
-	result, err = intBinding.Instance(false)
+	result, err = intBinding.Instance(context.Background(), false)
 	if err != nil {
 		t.Errorf(`expected nil, got: %v`, err)
 	}
@@ -140,7 +170,7 @@ func Test_valueBinding_Instance_noInitialize(t *testing.T) {
 	}
 
 	structBinding := &valueBinding[testStruct]{}
-	result, err = structBinding.Instance(false)
+	result, err = structBinding.Instance(context.Background(), false)
 	if err != nil {
 		t.Errorf(`expected nil, got: %v`, err)
 	}
@@ -151,7 +181,7 @@ func Test_valueBinding_Instance_noInitialize(t *testing.T) {
 
 func Test_valueBinding_Instance_initialize(t *testing.T) {
 	stringBinding := &valueBinding[string]{}
-	result, err := stringBinding.Instance(true)
+	result, err := stringBinding.Instance(context.Background(), true)
 	if err != nil {
 		t.Errorf(`expected nil, got: %v`, err)
 	}
@@ -160,7 +190,7 @@ func Test_valueBinding_Instance_initialize(t *testing.T) {
 	}
 
 	intBinding := &valueBinding[int]{}
-	result, err = intBinding.Instance(true)
+	result, err = intBinding.Instance(context.Background(), true)
 	if err != nil {
 		t.Errorf(`expected nil, got: %v`, err)
 	}
@@ -169,7 +199,7 @@ func Test_valueBinding_Instance_initialize(t *testing.T) {
 	}
 
 	structBinding := &valueBinding[testStruct]{}
-	result, err = structBinding.Instance(true)
+	result, err = structBinding.Instance(context.Background(), true)
 	if err != nil {
 		t.Errorf(`expected nil, got: %v`, err)
 	}
@@ -181,6 +211,26 @@ func Test_valueBinding_Instance_initialize(t *testing.T) {
 	}
 }
 
+func Test_valueBinding_Instance_initializeWithError(t *testing.T) {
+	result, err := valueBinding[testErrorStruct]{}.Instance(context.Background(), true)
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if !reflect.DeepEqual(result, testErrorStruct{
+		done: true,
+	}) {
+		t.Errorf(`expected initialized struct, got: %v`, result)
+	}
+
+	result, err = valueBinding[testFailingStruct]{}.Instance(context.Background(), true)
+	if !errors.Is(err, errTestInit) {
+		t.Errorf(`expected init error, got: %v`, err)
+	}
+	if result != nil {
+		t.Errorf(`expected nil, got: %v`, result)
+	}
+}
+
 func TestAsValue(t *testing.T) {
 	result := AsValue[string, string]()
 	if !reflect.DeepEqual(result, &bindingSource[string]{
@@ -201,7 +251,7 @@ func TestAsValue(t *testing.T) {
 
 func Test_pointerBinding_Instance_noInitialize(t *testing.T) {
 	stringBinding := &pointerBinding[string]{}
-	result, err := stringBinding.Instance(false)
+	result, err := stringBinding.Instance(context.Background(), false)
 	if err != nil {
 		t.Errorf(`expected nil, got: %v`, err)
 	}
@@ -211,7 +261,7 @@ func Test_pointerBinding_Instance_noInitialize(t *testing.T) {
 	}
 
 	intBinding := &pointerBinding[int]{}
-	result, err = intBinding.Instance(false)
+	result, err = intBinding.Instance(context.Background(), false)
 	if err != nil {
 		t.Errorf(`expected nil, got: %v`, err)
 	}
@@ -221,7 +271,7 @@ func Test_pointerBinding_Instance_noInitialize(t *testing.T) {
 	}
 
 	structBinding := &pointerBinding[testStruct]{}
-	result, err = structBinding.Instance(false)
+	result, err = structBinding.Instance(context.Background(), false)
 	if err != nil {
 		t.Errorf(`expected nil, got: %v`, err)
 	}
@@ -232,7 +282,7 @@ func Test_pointerBinding_Instance_noInitialize(t *testing.T) {
 
 func Test_pointerBinding_Instance_initialize(t *testing.T) {
 	stringBinding := &pointerBinding[string]{}
-	result, err := stringBinding.Instance(true)
+	result, err := stringBinding.Instance(context.Background(), true)
 	if err != nil {
 		t.Errorf(`expected nil, got: %v`, err)
 	}
@@ -242,7 +292,7 @@ func Test_pointerBinding_Instance_initialize(t *testing.T) {
 	}
 
 	intBinding := &pointerBinding[int]{}
-	result, err = intBinding.Instance(true)
+	result, err = intBinding.Instance(context.Background(), true)
 	if err != nil {
 		t.Errorf(`expected nil, got: %v`, err)
 	}
@@ -252,7 +302,7 @@ func Test_pointerBinding_Instance_initialize(t *testing.T) {
 	}
 
 	structBinding := &pointerBinding[testStruct]{}
-	result, err = structBinding.Instance(true)
+	result, err = structBinding.Instance(context.Background(), true)
 	if err != nil {
 		t.Errorf(`expected nil, got: %v`, err)
 	}
@@ -264,6 +314,26 @@ func Test_pointerBinding_Instance_initialize(t *testing.T) {
 	}
 }
 
+func Test_pointerBinding_Instance_initializeWithError(t *testing.T) {
+	result, err := pointerBinding[testErrorStruct]{}.Instance(context.Background(), true)
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if !reflect.DeepEqual(result, &testErrorStruct{
+		done: true,
+	}) {
+		t.Errorf(`expected initialized struct, got: %v`, result)
+	}
+
+	result, err = pointerBinding[testFailingStruct]{}.Instance(context.Background(), true)
+	if !errors.Is(err, errTestInit) {
+		t.Errorf(`expected init error, got: %v`, err)
+	}
+	if result != nil {
+		t.Errorf(`expected nil, got: %v`, result)
+	}
+}
+
 func TestAsPointer(t *testing.T) {
 	result := AsPointer[*string, *string]()
 	if !reflect.DeepEqual(result, &bindingSource[*string]{
@@ -286,7 +356,7 @@ func Test_ProviderMethod_Instance(t *testing.T) {
 	var stringBinding ProviderMethod[string] = func() (string, error) {
 		return "value", nil
 	}
-	result, err := stringBinding.Instance(true)
+	result, err := stringBinding.Instance(context.Background(), true)
 	if err != nil {
 		t.Errorf(`expected nil, got: %v`, err)
 	}
@@ -298,7 +368,7 @@ func Test_ProviderMethod_Instance(t *testing.T) {
 		value := 10
 		return &value, nil
 	}
-	result, err = intBinding.Instance(true)
+	result, err = intBinding.Instance(context.Background(), true)
 	if err != nil {
 		t.Errorf(`expected nil, got: %v`, err)
 	}
@@ -313,7 +383,7 @@ func Test_ProviderMethod_Instance(t *testing.T) {
 			b: 5,
 		}, nil
 	}
-	result, err = structBinding.Instance(true)
+	result, err = structBinding.Instance(context.Background(), true)
 	if err != nil {
 		t.Errorf(`expected nil, got: %v`, err)
 	}
@@ -337,7 +407,72 @@ func TestAsProvider(t *testing.T) {
 		t.Errorf(`expected nil, got: %v`, err)
 	}
 
-	instance, err := binding.Instance(true)
+	instance, err := binding.Instance(context.Background(), true)
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+
+	if !reflect.DeepEqual(instance, &testStruct{
+		a: "a",
+		b: 5,
+	}) {
+		t.Error("instances are different")
+	}
+}
+
+func Test_ContextProviderMethod_Instance(t *testing.T) {
+	var stringBinding ContextProviderMethod[string] = func(ctx context.Context) (string, error) {
+		return ctx.Value(testContextKey{}).(string), nil
+	}
+
+	ctx := context.WithValue(context.Background(), testContextKey{}, "value")
+	result, err := stringBinding.Instance(ctx, true)
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if result != "value" {
+		t.Errorf(`expected value, got: %v`, result)
+	}
+
+	result, err = stringBinding.Instance(context.Background(), false)
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if result != "" {
+		t.Errorf(`expected empty string, got: %v`, result)
+	}
+
+	var structBinding ContextProviderMethod[*testStruct] = func(ctx context.Context) (*testStruct, error) {
+		return nil, errors.New("error")
+	}
+	result, err = structBinding.Instance(context.Background(), true)
+	if err == nil {
+		t.Error("expected error, got nil")
+	}
+
+	result, err = structBinding.Instance(context.Background(), false)
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if result.(*testStruct) != nil {
+		t.Errorf(`expected nil pointer, got: %v`, result)
+	}
+}
+
+func TestAsContextProvider(t *testing.T) {
+	result := AsContextProvider[*testStruct](func(ctx context.Context) (*testStruct, error) {
+		return &testStruct{
+			a: ctx.Value(testContextKey{}).(string),
+			b: 5,
+		}, nil
+	})
+	binding, err := result.Binding()
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+
+	ctx := context.WithValue(context.Background(), testContextKey{}, "a")
+	instance, err := binding.Instance(ctx, true)
 	if err != nil {
 		t.Errorf(`expected nil, got: %v`, err)
 	}
@@ -350,11 +485,44 @@ func TestAsProvider(t *testing.T) {
 	}
 }
 
+func TestAsContextProvider_interface(t *testing.T) {
+	called := false
+	result := AsContextProvider[testDependency](func(ctx context.Context) (testDependency, error) {
+		called = true
+		return &testDependencyStruct{
+			value: "a",
+		}, nil
+	})
+	binding, err := result.Binding()
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+	if called {
+		t.Error("expected ContextProviderMethod not to be executed while binding")
+	}
+
+	instance, err := binding.Instance(context.Background(), true)
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if instance.(testDependency).Value() != "a" {
+		t.Error("instances are different")
+	}
+
+	_, err = AsContextProvider[*testStruct](func(ctx context.Context) (testDependency, error) {
+		return nil, nil
+	}).Binding()
+	var mismatchErr *TypeMismatchError
+	if !errors.As(err, &mismatchErr) {
+		t.Errorf(`expected TypeMismatchError, got: %v`, err)
+	}
+}
+
 func Test_instanceBinding_Instance(t *testing.T) {
 	stringBinding := &instanceBinding[string]{
 		instance: "value",
 	}
-	result, err := stringBinding.Instance(true)
+	result, err := stringBinding.Instance(context.Background(), true)
 	if err != nil {
 		t.Errorf(`expected nil, got: %v`, err)
 	}
@@ -365,7 +533,7 @@ func Test_instanceBinding_Instance(t *testing.T) {
 	intBinding := &instanceBinding[int]{
 		instance: 10,
 	}
-	result, err = intBinding.Instance(true)
+	result, err = intBinding.Instance(context.Background(), true)
 	if err != nil {
 		t.Errorf(`expected nil, got: %v`, err)
 	}
@@ -379,7 +547,7 @@ func Test_instanceBinding_Instance(t *testing.T) {
 			b: 5,
 		},
 	}
-	result, err = structBinding.Instance(true)
+	result, err = structBinding.Instance(context.Background(), true)
 	if err != nil {
 		t.Errorf(`expected nil, got: %v`, err)
 	}
@@ -440,16 +608,16 @@ func Test_bindingOption_Binding(t *testing.T) {
 func Test_bindingOption_Container(t *testing.T) {
 	option := &bindingOption{
 		keyOption: &testKeyOption{
-			container: func(container Container) Container {
-				return Container{
+			container: func(container *Container) *Container {
+				return newTestContainer(map[interface{}]Binding{
 					"something": nil,
-				}
+				})
 			},
 		},
 	}
 
 	container := option.Container(nil)
-	if !reflect.DeepEqual(container, Container{
+	if !reflect.DeepEqual(container.snapshot(), map[interface{}]Binding{
 		"something": nil,
 	}) {
 		t.Error("containers are different")
@@ -489,7 +657,7 @@ func Test_singletonBinding_Instance(t *testing.T) {
 		t.Error("unexpected error")
 	}
 
-	instance, err := binding.Instance(true)
+	instance, err := binding.Instance(context.Background(), true)
 	if err != nil {
 		t.Error("unexpected error")
 	}
@@ -500,7 +668,7 @@ func Test_singletonBinding_Instance(t *testing.T) {
 		t.Errorf(`expected value 2, go: %d`, singleton)
 	}
 
-	instance, err = binding.Instance(true)
+	instance, err = binding.Instance(context.Background(), true)
 	if err != nil {
 		t.Error("unexpected error")
 	}
@@ -512,6 +680,153 @@ func Test_singletonBinding_Instance(t *testing.T) {
 	}
 }
 
+func Test_singletonBinding_Instance_concurrent(t *testing.T) {
+	var counter int32
+	release := make(chan struct{})
+
+	binding := &singletonBinding{
+		parent: &testBinding{
+			instance: func(initialize bool) (interface{}, error) {
+				<-release
+				return &testStruct{
+					b: int(atomic.AddInt32(&counter, 1)),
+				}, nil
+			},
+		},
+	}
+
+	instances := make([]interface{}, 20)
+	var group sync.WaitGroup
+	for i := range instances {
+		group.Add(1)
+		go func(index int) {
+			defer group.Done()
+
+			instance, err := binding.Instance(context.Background(), true)
+			if err != nil {
+				t.Error("unexpected error")
+			}
+			instances[index] = instance
+		}(i)
+	}
+
+	close(release)
+	group.Wait()
+
+	if counter != 1 {
+		t.Errorf(`expected value 1, go: %d`, counter)
+	}
+	for _, instance := range instances {
+		if instance != instances[0] {
+			t.Error("instance are different")
+		}
+	}
+}
+
+func Test_singletonBinding_Instance_concurrentError(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var counter int32
+		errs := make([]error, 20)
+		release := make(chan struct{})
+
+		binding := &singletonBinding{
+			parent: &testBinding{
+				instance: func(initialize bool) (interface{}, error) {
+					<-release
+					return nil, errors.New(fmt.Sprint(atomic.AddInt32(&counter, 1)))
+				},
+			},
+		}
+
+		var group sync.WaitGroup
+		for i := range errs {
+			group.Add(1)
+			go func(index int) {
+				defer group.Done()
+				_, errs[index] = binding.Instance(context.Background(), true)
+			}(i)
+		}
+
+		// all goroutines are blocked, either by the construction or by waiting for it
+		synctest.Wait()
+		close(release)
+		group.Wait()
+
+		if counter != 1 {
+			t.Errorf(`expected value 1, go: %d`, counter)
+		}
+		for _, err := range errs {
+			if err == nil || err != errs[0] {
+				t.Errorf(`expected the same error, got: %v and %v`, err, errs[0])
+			}
+		}
+
+		binding.parent = &testBinding{
+			instance: func(initialize bool) (interface{}, error) {
+				return nil, errors.New(fmt.Sprint(atomic.AddInt32(&counter, 1)))
+			},
+		}
+
+		_, err := binding.Instance(context.Background(), true)
+		if err == nil || err == errs[0] || err.Error() != "2" {
+			t.Errorf(`expected new error, got: %v`, err)
+		}
+	})
+}
+
+func Test_singletonBinding_Instance_reentrant(t *testing.T) {
+	inner := NewContainer()
+	MustBind[*testSingletonStruct](AsContextProvider[*testSingletonStruct](func(ctx context.Context) (*testSingletonStruct, error) {
+		_, err := NewInstance[*testSingletonStruct](WithContainer(inner))
+		return &testSingletonStruct{}, err
+	}), AsSingleton(), WithContainer(inner))
+
+	done := make(chan error)
+	go func() {
+		_, err := NewInstance[*testSingletonStruct](WithContainer(inner))
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		var cycleErr *CycleError
+		if !errors.As(err, &cycleErr) {
+			t.Fatalf(`expected CycleError, got: %v`, err)
+		}
+
+		key := Key{Value: (*(*testSingletonStruct))(nil)}
+		if !reflect.DeepEqual(cycleErr.Path, []Key{key, key}) {
+			t.Errorf(`expected path of the singleton, got: %v`, cycleErr.Path)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected re-entrant singleton not to block")
+	}
+}
+
+func Test_singletonBinding_Instance_panic(t *testing.T) {
+	binding := &singletonBinding{
+		parent: &testBinding{
+			instance: func(initialize bool) (interface{}, error) {
+				panic("panic")
+			},
+		},
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("the code did not panic")
+			}
+		}()
+
+		_, _ = binding.Instance(context.Background(), true)
+	}()
+
+	if binding.call != nil {
+		t.Error("expected finished construction")
+	}
+}
+
 func TestAsSingleton(t *testing.T) {
 	result := AsSingleton()
 
@@ -547,13 +862,12 @@ func TestWithAnnotation(t *testing.T) {
 }
 
 func TestWithContainer(t *testing.T) {
-	result := WithContainer(Container{
+	container := newTestContainer(map[interface{}]Binding{
 		"first": nil,
 	})
+	result := WithContainer(container)
 	if !reflect.DeepEqual(&containerKeyOption{
-		container: Container{
-			"first": nil,
-		},
+		container: container,
 	}, result.(*bindingOption).keyOption) {
 		t.Error("containerKeyOption does not contain the right value")
 	}
@@ -1,47 +1,81 @@
 package genjector
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // sliceBinding is a concrete implementation for Binding interface.
 type sliceBinding[T any] struct {
-	previous *sliceBinding[T]
+	previous Binding
 	current  Binding
 }
 
 // Instance returns a slice of T types by executing current Binding and
 // all other preceding ones. First it places previous in a slice, and
-// then stores the instance of the current.
+// then stores the instance of the current. In case the previous Binding
+// is defined with conditions, the one active inside the Container is used.
 //
 // It respects Binding interface.
-func (b *sliceBinding[T]) Instance(initialize bool) (interface{}, error) {
+func (b *sliceBinding[T]) Instance(ctx context.Context, initialize bool) (interface{}, error) {
 	var result []T
-	if initialize && b.previous != nil {
-		instance, err := b.previous.Instance(initialize)
+	if previous, ok := activeFromContext(ctx, b.previous); initialize && ok {
+		instance, err := previous.Instance(ctx, initialize)
 		if err != nil {
 			return nil, err
 		}
 
 		transformed, ok := instance.([]T)
 		if !ok {
-			return nil, fmt.Errorf(`binding is not possible for "%v" and "%v"`, result, instance)
+			return nil, newTypeMismatchError[[]T](resolutionFromContext(ctx).key, instance)
 		}
 		result = append(result, transformed...)
 	}
 
-	instance, err := b.current.Instance(initialize)
+	instance, err := b.current.Instance(ctx, initialize)
 	if err != nil {
 		return nil, err
 	}
 
 	transformed, ok := instance.(T)
 	if !ok {
-		return nil, fmt.Errorf(`binding is not possible for "%v" and "%v"`, result, instance)
+		return nil, newTypeMismatchError[T](resolutionFromContext(ctx).key, instance)
 	}
 
 	result = append(result, transformed)
 	return result, err
 }
 
+// members delivers all Binding instances stored in the slice that are active
+// inside the Container, in order of their definition.
+//
+// It respects groupBinding interface.
+func (b *sliceBinding[T]) members(container *Container) []graphMember {
+	var result []graphMember
+	if previous, ok := container.active(b.previous); ok {
+		if group, ok := previous.(*sliceBinding[T]); ok {
+			result = group.members(container)
+		}
+	}
+
+	return append(result, graphMember{
+		name:    fmt.Sprint(len(result)),
+		element: baseKeySource[T]{}.Key(),
+		binding: b.current,
+	})
+}
+
+// chained delivers the previous Binding in case it can be extended by the next
+// member of a slice (or a map). That is the group Binding of type G, or the Binding
+// defined with conditions, whose active alternative is selected for every instance.
+func chained[G Binding](previous Binding) (Binding, bool) {
+	switch previous.(type) {
+	case G, *conditionalBinding:
+		return previous, true
+	}
+	return nil, false
+}
+
 // sliceBindingSource is a concrete implementation for BindingSource interface.
 type sliceBindingSource[T any] struct {
 	previous  Binding
@@ -61,17 +95,16 @@ func (b *sliceBindingSource[T]) Binding() (Binding, error) {
 		return nil, err
 	}
 
-	instance, err := binding.Instance(false)
+	instance, err := binding.Instance(context.Background(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	if _, ok := instance.(T); !ok {
-		var initial T
-		return nil, fmt.Errorf(`binding is not possible for "%v" and "%v"`, initial, instance)
+		return nil, newTypeMismatchError[T](baseKeySource[T]{}.Key(), instance)
 	}
 
-	previous, ok := b.previous.(*sliceBinding[T])
+	previous, ok := chained[*sliceBinding[T]](b.previous)
 	if !ok {
 		return &sliceBinding[T]{
 			current: binding,
@@ -124,44 +157,89 @@ func InSlice[T any](source BindingSource[T]) BindingSource[T] {
 
 // mapBinding is a concrete implementation for Binding interface.
 type mapBinding[K comparable, T any] struct {
-	previous *mapBinding[K, T]
+	previous Binding
 	key      K
 	current  Binding
 }
 
 // Instance returns a map of K-T pairs by executing current Binding and
-// all other preceding ones.
+// all other preceding ones. In case the previous Binding is defined with
+// conditions, the one active inside the Container is used.
 //
 // It respects Binding interface.
-func (b *mapBinding[K, T]) Instance(initialize bool) (interface{}, error) {
+func (b *mapBinding[K, T]) Instance(ctx context.Context, initialize bool) (interface{}, error) {
 	result := map[K]T{}
-	if initialize && b.previous != nil {
-		instance, err := b.previous.Instance(initialize)
+	if previous, ok := activeFromContext(ctx, b.previous); initialize && ok {
+		instance, err := previous.Instance(ctx, initialize)
 		if err != nil {
 			return nil, err
 		}
 
 		transformed, ok := instance.(map[K]T)
 		if !ok {
-			return nil, fmt.Errorf(`binding is not possible for "%v" and "%v"`, result, instance)
+			return nil, newTypeMismatchError[map[K]T](resolutionFromContext(ctx).key, instance)
 		}
 		result = transformed
 	}
 
-	instance, err := b.current.Instance(initialize)
+	instance, err := b.current.Instance(ctx, initialize)
 	if err != nil {
 		return nil, err
 	}
 
 	transformed, ok := instance.(T)
 	if !ok {
-		return nil, fmt.Errorf(`binding is not possible for "%v" and "%v"`, result, instance)
+		return nil, newTypeMismatchError[T](resolutionFromContext(ctx).key, instance)
 	}
 
 	result[b.key] = transformed
 	return result, err
 }
 
+// members delivers all Binding instances stored in the map that are active
+// inside the Container, in order of their definition. Binding instances
+// overridden with the same key are not delivered.
+//
+// It respects groupBinding interface.
+func (b *mapBinding[K, T]) members(container *Container) []graphMember {
+	var result []graphMember
+	if previous, ok := b.previousGroup(container); ok {
+		for _, member := range previous.members(container) {
+			if member.name != fmt.Sprint(b.key) {
+				result = append(result, member)
+			}
+		}
+	}
+
+	return append(result, graphMember{
+		name:    fmt.Sprint(b.key),
+		element: baseKeySource[T]{}.Key(),
+		binding: b.current,
+	})
+}
+
+// member delivers the Binding stored in the map under the key, respecting
+// the latest definition for the same key that is active inside the Container.
+func (b *mapBinding[K, T]) member(container *Container, key K) (Binding, bool) {
+	for current, ok := b, true; ok; current, ok = current.previousGroup(container) {
+		if current.key == key {
+			return current.current, true
+		}
+	}
+	return nil, false
+}
+
+// previousGroup delivers the previous mapBinding that is active inside the Container.
+func (b *mapBinding[K, T]) previousGroup(container *Container) (*mapBinding[K, T], bool) {
+	previous, ok := container.active(b.previous)
+	if !ok {
+		return nil, false
+	}
+
+	group, ok := previous.(*mapBinding[K, T])
+	return group, ok
+}
+
 // mapBindingSource is a concrete implementation for BindingSource interface.
 type mapBindingSource[K comparable, T any] struct {
 	previous  Binding
@@ -182,13 +260,12 @@ func (b *mapBindingSource[K, T]) Binding() (Binding, error) {
 		return nil, err
 	}
 
-	instance, _ := binding.Instance(false)
+	instance, _ := binding.Instance(context.Background(), false)
 	if _, ok := instance.(T); !ok {
-		var initial T
-		return nil, fmt.Errorf(`binding is not possible for "%v" and "%v"`, initial, instance)
+		return nil, newTypeMismatchError[T](baseKeySource[T]{}.Key(), instance)
 	}
 
-	previous, ok := b.previous.(*mapBinding[K, T])
+	previous, ok := chained[*mapBinding[K, T]](b.previous)
 	if !ok {
 		return &mapBinding[K, T]{
 			key:     b.key,
@@ -1,6 +1,7 @@
 package genjector
 
 import (
+	"context"
 	"errors"
 	"reflect"
 	"testing"
@@ -22,7 +23,7 @@ func (o *testBindingSource) Key() Key {
 type testBindingOption struct {
 	binding   func(binding Binding) (Binding, error)
 	key       func(key Key) Key
-	container func(container Container) Container
+	container func(container *Container) *Container
 }
 
 func (o *testBindingOption) Binding(binding Binding) (Binding, error) {
@@ -33,7 +34,7 @@ func (o *testBindingOption) Key(key Key) Key {
 	return o.key(key)
 }
 
-func (o *testBindingOption) Container(container Container) Container {
+func (o *testBindingOption) Container(container *Container) *Container {
 	return o.container(container)
 }
 
@@ -46,7 +47,7 @@ func Test_sliceBinding_firstItem_error(t *testing.T) {
 		},
 	}
 
-	instance, err := binding.Instance(true)
+	instance, err := binding.Instance(context.Background(), true)
 	if err == nil {
 		t.Error("expected error, got nil")
 	}
@@ -65,7 +66,7 @@ func Test_sliceBinding_firstItem_invalid(t *testing.T) {
 		},
 	}
 
-	instance, err := binding.Instance(true)
+	instance, err := binding.Instance(context.Background(), true)
 	if err == nil {
 		t.Error("expected error, got nil")
 	}
@@ -87,7 +88,7 @@ func Test_sliceBinding_firstItem_success(t *testing.T) {
 		},
 	}
 
-	instance, err := binding.Instance(true)
+	instance, err := binding.Instance(context.Background(), true)
 	if err != nil {
 		t.Errorf("expected nil, got error %s", err)
 	}
@@ -121,7 +122,7 @@ func Test_sliceBinding_slice_error(t *testing.T) {
 		},
 	}
 
-	instance, err := binding.Instance(true)
+	instance, err := binding.Instance(context.Background(), true)
 	if err == nil {
 		t.Error("expected error, got nil")
 	}
@@ -150,7 +151,7 @@ func Test_sliceBinding_slice_invalid(t *testing.T) {
 		},
 	}
 
-	instance, err := binding.Instance(true)
+	instance, err := binding.Instance(context.Background(), true)
 	if err == nil {
 		t.Error("expected error, got nil")
 	}
@@ -182,7 +183,7 @@ func Test_sliceBinding_slice_success(t *testing.T) {
 		},
 	}
 
-	instance, err := binding.Instance(true)
+	instance, err := binding.Instance(context.Background(), true)
 	if err != nil {
 		t.Errorf("expected nil, got error %s", err)
 	}
@@ -223,7 +224,7 @@ func Test_sliceBinding_slice_success_noInitialize(t *testing.T) {
 		},
 	}
 
-	instance, err := binding.Instance(false)
+	instance, err := binding.Instance(context.Background(), false)
 	if err != nil {
 		t.Errorf("expected nil, got error %s", err)
 	}
@@ -324,7 +325,7 @@ func Test_sliceBindingSource_Binding_current_success(t *testing.T) {
 		t.Errorf("expected nil, got error %s", err)
 	}
 
-	instance, err := binding.Instance(true)
+	instance, err := binding.Instance(context.Background(), true)
 	if err != nil {
 		t.Errorf("expected nil, got error %s", err)
 	}
@@ -370,7 +371,7 @@ func Test_sliceBindingSource_Binding_previous_success(t *testing.T) {
 		t.Errorf("expected nil, got error %s", err)
 	}
 
-	instance, err := binding.Instance(true)
+	instance, err := binding.Instance(context.Background(), true)
 	if err != nil {
 		t.Errorf("expected nil, got error %s", err)
 	}
@@ -424,6 +425,44 @@ func TestInSlice(t *testing.T) {
 	}
 }
 
+func TestInSlice_conditional(t *testing.T) {
+	container := NewContainer()
+	MustBind[int](InSlice[int](AsInstance[int](1)), WithContainer(container))
+	MustBind[int](InSlice[int](AsInstance[int](2)), WithContainer(container), WithProfile("x"))
+	MustBind[int](InSlice[int](AsInstance[int](3)), WithContainer(container))
+
+	values, err := NewInstance[[]int](WithContainer(container))
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+	if !reflect.DeepEqual(values, []int{1, 3}) {
+		t.Errorf(`expected [1 3], got: %v`, values)
+	}
+
+	container.SetActiveProfiles("x")
+	values, err = NewInstance[[]int](WithContainer(container))
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+	if !reflect.DeepEqual(values, []int{1, 2, 3}) {
+		t.Errorf(`expected [1 2 3], got: %v`, values)
+	}
+}
+
+func TestInSlice_conditionalFirst(t *testing.T) {
+	container := NewContainer()
+	MustBind[int](InSlice[int](AsInstance[int](1)), WithContainer(container), WithProfile("x"))
+	MustBind[int](InSlice[int](AsInstance[int](2)), WithContainer(container))
+
+	values, err := NewInstance[[]int](WithContainer(container))
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+	if !reflect.DeepEqual(values, []int{2}) {
+		t.Errorf(`expected [2], got: %v`, values)
+	}
+}
+
 func Test_mapBinding_firstItem_error(t *testing.T) {
 	binding := &mapBinding[string, testStruct]{
 		current: &testBinding{
@@ -433,7 +472,7 @@ func Test_mapBinding_firstItem_error(t *testing.T) {
 		},
 	}
 
-	instance, err := binding.Instance(true)
+	instance, err := binding.Instance(context.Background(), true)
 	if err == nil {
 		t.Error("expected error, got nil")
 	}
@@ -452,7 +491,7 @@ func Test_mapBinding_firstItem_invalid(t *testing.T) {
 		},
 	}
 
-	instance, err := binding.Instance(true)
+	instance, err := binding.Instance(context.Background(), true)
 	if err == nil {
 		t.Error("expected error, got nil")
 	}
@@ -475,7 +514,7 @@ func Test_mapBinding_firstItem_success(t *testing.T) {
 		},
 	}
 
-	instance, err := binding.Instance(true)
+	instance, err := binding.Instance(context.Background(), true)
 	if err != nil {
 		t.Errorf("expected nil, got error %s", err)
 	}
@@ -509,7 +548,7 @@ func Test_mapBinding_map_error(t *testing.T) {
 		},
 	}
 
-	instance, err := binding.Instance(true)
+	instance, err := binding.Instance(context.Background(), true)
 	if err == nil {
 		t.Error("expected error, got nil")
 	}
@@ -538,7 +577,7 @@ func Test_mapBinding_map_invalid(t *testing.T) {
 		},
 	}
 
-	instance, err := binding.Instance(true)
+	instance, err := binding.Instance(context.Background(), true)
 	if err == nil {
 		t.Error("expected error, got nil")
 	}
@@ -572,7 +611,7 @@ func Test_mapBinding_map_success(t *testing.T) {
 		},
 	}
 
-	instance, err := binding.Instance(true)
+	instance, err := binding.Instance(context.Background(), true)
 	if err != nil {
 		t.Errorf("expected nil, got error %s", err)
 	}
@@ -615,7 +654,7 @@ func Test_mapBinding_map_success_noInitialize(t *testing.T) {
 		},
 	}
 
-	instance, err := binding.Instance(false)
+	instance, err := binding.Instance(context.Background(), false)
 	if err != nil {
 		t.Errorf("expected nil, got error %s", err)
 	}
@@ -717,7 +756,7 @@ func Test_mapBindingSource_Binding_current_success(t *testing.T) {
 		t.Errorf("expected nil, got error %s", err)
 	}
 
-	instance, err := binding.Instance(true)
+	instance, err := binding.Instance(context.Background(), true)
 	if err != nil {
 		t.Errorf("expected nil, got error %s", err)
 	}
@@ -765,7 +804,7 @@ func Test_mapBindingSource_Binding_previous_success(t *testing.T) {
 		t.Errorf("expected nil, got error %s", err)
 	}
 
-	instance, err := binding.Instance(true)
+	instance, err := binding.Instance(context.Background(), true)
 	if err != nil {
 		t.Errorf("expected nil, got error %s", err)
 	}
@@ -808,6 +847,30 @@ func Test_mapBindingSource_Key(t *testing.T) {
 	}
 }
 
+func TestInMap_conditional(t *testing.T) {
+	container := NewContainer()
+	MustBind[int](InMap[string, int]("first", AsInstance[int](1)), WithContainer(container))
+	MustBind[int](InMap[string, int]("first", AsInstance[int](2)), WithContainer(container), WithProfile("x"))
+	MustBind[int](InMap[string, int]("second", AsInstance[int](3)), WithContainer(container))
+
+	values, err := NewInstance[map[string]int](WithContainer(container))
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+	if !reflect.DeepEqual(values, map[string]int{"first": 1, "second": 3}) {
+		t.Errorf(`unexpected map: %v`, values)
+	}
+
+	container.SetActiveProfiles("x")
+	values, err = NewInstance[map[string]int](WithContainer(container))
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+	if !reflect.DeepEqual(values, map[string]int{"first": 2, "second": 3}) {
+		t.Errorf(`unexpected map: %v`, values)
+	}
+}
+
 func TestInMap(t *testing.T) {
 	source := InMap[string, int]("key", &testBindingSource{})
 
@@ -0,0 +1,309 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// accessor holds a registration together with the name of its method
+// inside the generated resolver.
+type accessor struct {
+	*registration
+	name         string
+	dependencies []*accessor
+}
+
+// accessorName delivers the name of the method for the key and the annotation,
+// made from all letters and digits inside them.
+//
+// Example:
+// *repository.UserRepository with annotation "primary" delivers RepositoryUserRepositoryPrimary.
+func accessorName(key string, annotation string) string {
+	var builder strings.Builder
+	for _, part := range strings.FieldsFunc(key+" "+annotation, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		runes := []rune(part)
+		runes[0] = unicode.ToUpper(runes[0])
+		builder.WriteString(string(runes))
+	}
+
+	name := builder.String()
+	if len(name) == 0 || unicode.IsDigit([]rune(name)[0]) {
+		name = "Key" + name
+	}
+	return name
+}
+
+// accessors delivers all accessors sorted by their names. Later registrations
+// for the same key and annotation inside the same container override the earlier ones,
+// as it is done by the Container. Dependencies are resolved only from the same container.
+// It returns an error in case a dependency is not registered, dependencies make a cycle,
+// or the same key is registered inside different containers.
+func accessors(registrations []*registration) ([]*accessor, error) {
+	byKey := map[[3]string]*accessor{}
+	byName := map[string]*accessor{}
+
+	for _, registration := range registrations {
+		name := accessorName(registration.key, registration.annotation)
+		if existing, ok := byName[name]; ok {
+			if existing.key != registration.key || existing.annotation != registration.annotation {
+				return nil, fmt.Errorf(`%s: key "%s" has the same accessor name "%s" as key "%s"`, registration.position, registration.key, name, existing.key)
+			}
+			if existing.container != registration.container {
+				return nil, fmt.Errorf(`%s: key "%s" is already registered inside a different container at %s`, registration.position, registration.key, existing.position)
+			}
+		}
+
+		value := &accessor{
+			registration: registration,
+			name:         name,
+		}
+		byKey[[3]string{registration.container, registration.key, registration.annotation}] = value
+		byName[name] = value
+	}
+
+	result := make([]*accessor, 0, len(byName))
+	for _, value := range byName {
+		for _, dependency := range value.registration.dependencies {
+			dependent, ok := byKey[[3]string{value.container, dependency, ""}]
+			if !ok {
+				return nil, fmt.Errorf(`%s: dependency "%s" for key "%s" is not registered`, value.position, dependency, value.key)
+			}
+			value.dependencies = append(value.dependencies, dependent)
+		}
+		result = append(result, value)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].name < result[j].name
+	})
+
+	visited := map[*accessor]int{}
+	var visit func(value *accessor, path []string) error
+	visit = func(value *accessor, path []string) error {
+		path = append(path, value.key)
+		switch visited[value] {
+		case 1:
+			return fmt.Errorf(`%s: dependency cycle detected: %s`, value.position, strings.Join(path, " -> "))
+		case 2:
+			return nil
+		}
+
+		visited[value] = 1
+		for _, dependency := range value.dependencies {
+			err := visit(dependency, path)
+			if err != nil {
+				return err
+			}
+		}
+		visited[value] = 2
+		return nil
+	}
+
+	for _, value := range result {
+		err := visit(value, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// generate delivers the formatted source code of the resolver for the package.
+func generate(pkg *pkg, typeName string) ([]byte, error) {
+	values, err := accessors(pkg.registrations)
+	if err != nil {
+		return nil, err
+	}
+
+	imports := map[string]string{}
+	addImport := func(name string, path string) {
+		imports[path] = name
+	}
+
+	initialize := false
+	for _, value := range values {
+		for name, spec := range value.imports {
+			path, _ := strconv.Unquote(spec.Path.Value)
+			addImport(name, path)
+		}
+
+		switch value.kind {
+		case kindPointer, kindValue:
+			initialize = true
+		case kindContextProvider:
+			addImport("context", "context")
+		}
+		if value.singleton {
+			addImport("sync", "sync")
+		}
+	}
+	if initialize {
+		addImport("context", "context")
+		addImport("genjector", genjectorPath)
+	}
+
+	var buffer bytes.Buffer
+	fmt.Fprintf(&buffer, "// Code generated by genjector-gen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buffer, "package %s\n\n", pkg.name)
+
+	if len(imports) > 0 {
+		paths := make([]string, 0, len(imports))
+		for path := range imports {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+
+		buffer.WriteString("import (\n")
+		for _, standard := range []bool{true, false} {
+			written := false
+			for _, path := range paths {
+				if isStandard(path) != standard {
+					continue
+				}
+
+				name := imports[path]
+				if name == path[strings.LastIndex(path, "/")+1:] {
+					fmt.Fprintf(&buffer, "%s\n", strconv.Quote(path))
+				} else {
+					fmt.Fprintf(&buffer, "%s %s\n", name, strconv.Quote(path))
+				}
+				written = true
+			}
+
+			if written && standard {
+				buffer.WriteString("\n")
+			}
+		}
+		buffer.WriteString(")\n\n")
+	}
+
+	fmt.Fprintf(&buffer, "// %s delivers instances for all keys bound in the package,\n", typeName)
+	fmt.Fprintf(&buffer, "// without using the genjector.Container.\n")
+	fmt.Fprintf(&buffer, "//\n// It is safe for concurrent usage.\n")
+	fmt.Fprintf(&buffer, "type %s struct {\n", typeName)
+	for _, value := range values {
+		if value.singleton {
+			field := unexported(value.name)
+			fmt.Fprintf(&buffer, "%sMutex sync.Mutex\n", field)
+			fmt.Fprintf(&buffer, "%sDone bool\n", field)
+			fmt.Fprintf(&buffer, "%s %s\n", field, value.key)
+		}
+	}
+	buffer.WriteString("}\n\n")
+
+	fmt.Fprintf(&buffer, "// New%s delivers a new instance of %s.\n", typeName, typeName)
+	fmt.Fprintf(&buffer, "func New%s() *%s {\nreturn &%s{}\n}\n\n", typeName, typeName, typeName)
+
+	for _, value := range values {
+		writeAccessor(&buffer, typeName, value)
+	}
+
+	if initialize {
+		fmt.Fprintf(&buffer, "// initialize executes Init method of the instance, in the same way as genjector.Container does.\n")
+		fmt.Fprintf(&buffer, "func (*%s) initialize(instance interface{}) error {\n", typeName)
+		buffer.WriteString(`switch value := instance.(type) {
+case genjector.Initializable:
+	value.Init()
+case genjector.InitializableWithError:
+	return value.Init()
+case genjector.InitializableWithContext:
+	return value.Init(context.Background())
+}
+return nil
+}
+`)
+	}
+
+	return format.Source(buffer.Bytes())
+}
+
+// writeAccessor writes the method that delivers the instance for the key.
+// For singletons, it writes an additional method that makes the instance.
+func writeAccessor(buffer *bytes.Buffer, typeName string, value *accessor) {
+	description := value.key
+	if len(value.annotation) > 0 {
+		description += `" with annotation "` + value.annotation
+	}
+
+	method := value.name
+	if value.singleton {
+		field := unexported(value.name)
+
+		fmt.Fprintf(buffer, "// %s delivers the singleton instance for the key \"%s\".\n", value.name, description)
+		fmt.Fprintf(buffer, "func (r *%s) %s() (%s, error) {\n", typeName, value.name, value.key)
+		fmt.Fprintf(buffer, "r.%sMutex.Lock()\ndefer r.%sMutex.Unlock()\n\n", field, field)
+		fmt.Fprintf(buffer, "if r.%sDone {\nreturn r.%s, nil\n}\n\n", field, field)
+		fmt.Fprintf(buffer, "instance, err := r.new%s()\nif err != nil {\nreturn instance, err\n}\n\n", value.name)
+		fmt.Fprintf(buffer, "r.%s = instance\nr.%sDone = true\nreturn instance, nil\n}\n\n", field, field)
+
+		method = "new" + value.name
+		fmt.Fprintf(buffer, "// %s makes the instance for the key \"%s\".\n", method, description)
+	} else {
+		fmt.Fprintf(buffer, "// %s delivers a new instance for the key \"%s\".\n", method, description)
+	}
+
+	fmt.Fprintf(buffer, "func (r *%s) %s() (%s, error) {\n", typeName, method, value.key)
+	if value.kind != kindInstance {
+		fmt.Fprintf(buffer, "var empty %s\n\n", value.key)
+	}
+
+	switch value.kind {
+	case kindPointer:
+		fmt.Fprintf(buffer, "instance := &%s{}\n", value.implementation)
+		buffer.WriteString("if err := r.initialize(instance); err != nil {\nreturn empty, err\n}\n\nreturn instance, nil\n")
+	case kindValue:
+		fmt.Fprintf(buffer, "var instance %s\n", value.implementation)
+		buffer.WriteString("if err := r.initialize(&instance); err != nil {\nreturn empty, err\n}\n\nreturn instance, nil\n")
+	case kindInstance:
+		fmt.Fprintf(buffer, "return %s, nil\n", value.expression)
+	case kindProvider:
+		fmt.Fprintf(buffer, "instance, err := %s()\n", callable(value.expression))
+		buffer.WriteString("if err != nil {\nreturn empty, err\n}\n\nreturn instance, nil\n")
+	case kindContextProvider:
+		fmt.Fprintf(buffer, "instance, err := %s(context.Background())\n", callable(value.expression))
+		buffer.WriteString("if err != nil {\nreturn empty, err\n}\n\nreturn instance, nil\n")
+	case kindConstructor:
+		arguments := make([]string, 0, len(value.dependencies))
+		for i, dependency := range value.dependencies {
+			argument := fmt.Sprintf("dependency%d", i)
+			arguments = append(arguments, argument)
+			fmt.Fprintf(buffer, "%s, err := r.%s()\n", argument, dependency.name)
+			buffer.WriteString("if err != nil {\nreturn empty, err\n}\n\n")
+		}
+		fmt.Fprintf(buffer, "instance, err := %s(%s)\n", callable(value.expression), strings.Join(arguments, ", "))
+		buffer.WriteString("if err != nil {\nreturn empty, err\n}\n\nreturn instance, nil\n")
+	}
+
+	buffer.WriteString("}\n\n")
+}
+
+// unexported delivers the name with the first letter in lower case.
+func unexported(name string) string {
+	runes := []rune(name)
+	runes[0] = unicode.ToLower(runes[0])
+	return string(runes)
+}
+
+// callable delivers the expression that can be called directly. Expressions
+// other than identifiers (and selectors) are wrapped in parentheses.
+func callable(expression string) string {
+	for _, r := range expression {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '.' {
+			return "(" + expression + ")"
+		}
+	}
+	return expression
+}
+
+// isStandard checks if the import path belongs to the standard library.
+func isStandard(path string) bool {
+	return !strings.Contains(strings.SplitN(path, "/", 2)[0], ".")
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writePackage(t *testing.T, source string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "bindings.go"), []byte(source), 0o644)
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+	return dir
+}
+
+func TestAccessorName(t *testing.T) {
+	tests := []struct {
+		key        string
+		annotation string
+		expected   string
+	}{
+		{key: "*ConfigStruct", expected: "ConfigStruct"},
+		{key: "repository.UserRepository", annotation: "primary", expected: "RepositoryUserRepositoryPrimary"},
+		{key: "[]int", annotation: "first-value", expected: "IntFirstValue"},
+		{key: "map[string]int", expected: "MapStringInt"},
+	}
+
+	for _, test := range tests {
+		if name := accessorName(test.key, test.annotation); name != test.expected {
+			t.Errorf(`expected "%s", got: "%s"`, test.expected, name)
+		}
+	}
+}
+
+func TestGenerate_golden(t *testing.T) {
+	dir := filepath.Join("..", "..", "examples", "generated")
+
+	pkg, err := parsePackage(dir, "genjector_gen.go")
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+	if len(pkg.warnings) != 0 {
+		t.Errorf(`expected no warnings, got: %v`, pkg.warnings)
+	}
+
+	source, err := generate(pkg, "Resolver")
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+
+	expected, err := os.ReadFile(filepath.Join(dir, "genjector_gen.go"))
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+	if !bytes.Equal(source, expected) {
+		t.Errorf("generated code is different from examples/generated/genjector_gen.go, run go generate:\n%s", source)
+	}
+}
+
+func TestRun(t *testing.T) {
+	dir := writePackage(t, `package sample
+
+import (
+	di "github.com/ompluscator/genjector"
+	"strings"
+)
+
+type Value struct{}
+
+func register() {
+	di.MustBind[*strings.Builder](di.AsPointer[*strings.Builder, *strings.Builder]())
+	di.MustBind[Value](di.AsValue[Value, Value](), di.AsEagerSingleton())
+	di.MustBind[string](di.AsProvider[string](func() (string, error) {
+		return strings.ToUpper("value"), nil
+	}), di.WithAnnotation("upper"))
+	di.MustBind[int](di.AsConstructor2[int, int, *strings.Builder, Value](build))
+	di.MustBind[int](di.InSlice[int](di.AsInstance[int](1)))
+	di.MustBind[bool](di.AsInstance[bool](true), di.AsScoped())
+}
+
+func build(builder *strings.Builder, value Value) (int, error) {
+	return builder.Len(), nil
+}
+`)
+
+	err := run(dir, "Wiring", "wiring_gen.go")
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+
+	source, err := os.ReadFile(filepath.Join(dir, "wiring_gen.go"))
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+
+	for _, expected := range []string{
+		"type Wiring struct {",
+		`"github.com/ompluscator/genjector"`,
+		"case genjector.Initializable:",
+		"func (r *Wiring) StringsBuilder() (*strings.Builder, error) {",
+		"func (r *Wiring) Value() (Value, error) {",
+		"func (r *Wiring) StringUpper() (string, error) {",
+		"instance, err := build(dependency0, dependency1)",
+	} {
+		if !strings.Contains(string(source), expected) {
+			t.Errorf("expected %q inside generated code:\n%s", expected, source)
+		}
+	}
+	if strings.Contains(string(source), "func (r *Wiring) Bool()") {
+		t.Errorf("expected scoped registration to be skipped:\n%s", source)
+	}
+
+	pkg, err := parsePackage(dir, "wiring_gen.go")
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+	if len(pkg.warnings) != 2 {
+		t.Errorf(`expected 2 warnings, got: %v`, pkg.warnings)
+	}
+}
+
+func TestGenerate_errors(t *testing.T) {
+	tests := map[string]string{
+		"missing": `package sample
+
+import "github.com/ompluscator/genjector"
+
+func register() {
+	genjector.MustBind[int](genjector.AsConstructor1[int](func(value string) (int, error) {
+		return len(value), nil
+	}))
+}
+`,
+		"cycle": `package sample
+
+import "github.com/ompluscator/genjector"
+
+func register() {
+	genjector.MustBind[int](genjector.AsConstructor1[int](func(value string) (int, error) {
+		return len(value), nil
+	}))
+	genjector.MustBind[string](genjector.AsConstructor1[string](func(value int) (string, error) {
+		return "", nil
+	}))
+}
+`,
+		"implicit": `package sample
+
+import "github.com/ompluscator/genjector"
+
+func register() {
+	genjector.MustBind(genjector.InSlice(genjector.AsProvider(func() (int, error) {
+		return 1, nil
+	})))
+	genjector.MustBind(genjector.AsProvider(func() (int, error) {
+		return 1, nil
+	}))
+}
+`,
+		"other container": `package sample
+
+import "github.com/ompluscator/genjector"
+
+func register(container *genjector.Container) {
+	genjector.MustBind[string](genjector.AsInstance[string]("value"), genjector.WithContainer(container))
+	genjector.MustBind[int](genjector.AsConstructor1[int](func(value string) (int, error) {
+		return len(value), nil
+	}))
+}
+`,
+		"two containers": `package sample
+
+import "github.com/ompluscator/genjector"
+
+func register(first *genjector.Container, second *genjector.Container) {
+	genjector.MustBind[string](genjector.AsInstance[string]("first"), genjector.WithContainer(first))
+	genjector.MustBind[string](genjector.AsInstance[string]("second"), genjector.WithContainer(second))
+}
+`,
+	}
+
+	expected := map[string]string{
+		"missing":         `dependency "string" for key "int" is not registered`,
+		"cycle":           `dependency cycle detected`,
+		"implicit":        `type of the key for "AsProvider" must be explicit`,
+		"other container": `dependency "string" for key "int" is not registered`,
+		"two containers":  `key "string" is already registered inside a different container`,
+	}
+
+	for name, source := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := run(writePackage(t, source), "Resolver", "genjector_gen.go")
+			if err == nil || !strings.Contains(err.Error(), expected[name]) {
+				t.Errorf(`expected error "%s", got: %v`, expected[name], err)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+// Command genjector-gen generates a resolver with one typed method per key, from
+// the same genjector.Bind (and genjector.MustBind) calls that are used with
+// the genjector.Container.
+//
+// The generated resolver does not use the genjector.Container, so it delivers
+// instances without map lookups, interface boxing and type assertions.
+//
+// Usage:
+//
+//	//go:generate go run github.com/ompluscator/genjector/cmd/genjector-gen -type Resolver -output genjector_gen.go
+//
+// Supported binding sources are AsPointer, AsValue, AsProvider, AsContextProvider,
+// AsInstance and AsConstructor1..AsConstructor5, while supported binding options
+// are AsSingleton, AsEagerSingleton, WithAnnotation and WithContainer. Type of the key
+// must be explicit in the call, and all expressions used as providers (or constructors)
+// must be available on the package level. Registrations that use other binding sources
+// (or binding options) are skipped with a warning.
+//
+// Dependencies of constructors are resolved only from keys without annotations,
+// registered inside the same container. Containers are distinguished by the expression
+// used in WithContainer, or by its absence for the standard internal (global) container.
+// In case the dependency is not registered, dependencies make a cycle, or the same key
+// is registered inside different containers, the code is not generated.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	typeName := flag.String("type", "Resolver", "name of the generated resolver type")
+	output := flag.String("output", "genjector_gen.go", "name of the generated file")
+	flag.Parse()
+
+	dir := "."
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+
+	err := run(dir, *typeName, *output)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "genjector-gen:", err)
+		os.Exit(1)
+	}
+}
+
+// run generates the resolver for the package inside the directory,
+// and writes it to the output file inside the same directory.
+func run(dir string, typeName string, output string) error {
+	pkg, err := parsePackage(dir, output)
+	if err != nil {
+		return err
+	}
+
+	for _, warning := range pkg.warnings {
+		fmt.Fprintln(os.Stderr, "genjector-gen: warning:", warning)
+	}
+
+	source, err := generate(pkg, typeName)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, output), source, 0o644)
+}
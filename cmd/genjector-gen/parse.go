@@ -0,0 +1,357 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// genjectorPath is the import path of the genjector package.
+const genjectorPath = "github.com/ompluscator/genjector"
+
+// kind represents the BindingSource used for a registration.
+type kind int
+
+const (
+	kindPointer kind = iota
+	kindValue
+	kindProvider
+	kindContextProvider
+	kindConstructor
+	kindInstance
+)
+
+// registration holds everything needed for generating a single accessor,
+// as it is read from a Bind (or MustBind) call.
+type registration struct {
+	key            string
+	annotation     string
+	container      string
+	kind           kind
+	implementation string
+	expression     string
+	dependencies   []string
+	singleton      bool
+	imports        map[string]*ast.ImportSpec
+	position       token.Position
+}
+
+// pkg holds all registrations found inside a package.
+type pkg struct {
+	name          string
+	functions     map[string]*ast.FuncType
+	registrations []*registration
+	warnings      []string
+}
+
+// parsePackage reads all Go files in the directory, except tests and the output file,
+// and collects all registrations from Bind and MustBind calls.
+func parsePackage(dir string, output string) (*pkg, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	fset := token.NewFileSet()
+	result := &pkg{
+		functions: map[string]*ast.FuncType{},
+	}
+
+	var files []*ast.File
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") || filepath.Base(path) == filepath.Base(output) {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(result.name) == 0 {
+			result.name = file.Name.Name
+		} else if result.name != file.Name.Name {
+			return nil, fmt.Errorf(`%s: package "%s" does not match package "%s"`, path, file.Name.Name, result.name)
+		}
+
+		for _, declaration := range file.Decls {
+			if function, ok := declaration.(*ast.FuncDecl); ok && function.Recv == nil {
+				result.functions[function.Name.Name] = function.Type
+			}
+		}
+
+		files = append(files, file)
+	}
+
+	if len(result.name) == 0 {
+		return nil, fmt.Errorf(`no Go files found in "%s"`, dir)
+	}
+
+	var errs []string
+	for _, file := range files {
+		errs = append(errs, result.parseFile(fset, file)...)
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+
+	return result, nil
+}
+
+// parseFile collects all registrations from a single Go file,
+// and delivers all errors found inside it.
+func (p *pkg) parseFile(fset *token.FileSet, file *ast.File) []string {
+	parser := &fileParser{
+		fset:      fset,
+		imports:   map[string]*ast.ImportSpec{},
+		functions: p.functions,
+	}
+
+	for _, spec := range file.Imports {
+		importPath, _ := strconv.Unquote(spec.Path.Value)
+		name := importPath[strings.LastIndex(importPath, "/")+1:]
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+
+		if importPath == genjectorPath {
+			parser.genjector = name
+		}
+		parser.imports[name] = spec
+	}
+
+	if len(parser.genjector) == 0 {
+		return nil
+	}
+
+	var errs []string
+	ast.Inspect(file, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+
+		name, typeArguments := parser.genjectorCall(call)
+		if name != "Bind" && name != "MustBind" {
+			return true
+		}
+
+		registration, err := parser.registration(call, typeArguments)
+		if err != nil {
+			errs = append(errs, err.Error())
+			return false
+		}
+		if registration != nil {
+			p.registrations = append(p.registrations, registration)
+		}
+		return false
+	})
+
+	p.warnings = append(p.warnings, parser.warnings...)
+	return errs
+}
+
+// fileParser holds the state of parsing a single Go file.
+type fileParser struct {
+	fset      *token.FileSet
+	genjector string
+	imports   map[string]*ast.ImportSpec
+	functions map[string]*ast.FuncType
+	warnings  []string
+}
+
+// genjectorCall delivers the name of the function from the genjector package,
+// together with its type arguments, in case the call is made to that package.
+func (f *fileParser) genjectorCall(call *ast.CallExpr) (string, []ast.Expr) {
+	function := call.Fun
+
+	var typeArguments []ast.Expr
+	switch value := function.(type) {
+	case *ast.IndexExpr:
+		function = value.X
+		typeArguments = []ast.Expr{value.Index}
+	case *ast.IndexListExpr:
+		function = value.X
+		typeArguments = value.Indices
+	}
+
+	selector, ok := function.(*ast.SelectorExpr)
+	if !ok {
+		return "", nil
+	}
+
+	identifier, ok := selector.X.(*ast.Ident)
+	if !ok || identifier.Name != f.genjector {
+		return "", nil
+	}
+
+	return selector.Sel.Name, typeArguments
+}
+
+// registration delivers the registration for a Bind (or MustBind) call. In case the call
+// uses features that can not be generated, it delivers nil and stores a warning.
+func (f *fileParser) registration(call *ast.CallExpr, bindArguments []ast.Expr) (*registration, error) {
+	position := f.fset.Position(call.Pos())
+	if len(call.Args) == 0 {
+		return nil, fmt.Errorf(`%s: binding source is missing`, position)
+	}
+
+	source, ok := call.Args[0].(*ast.CallExpr)
+	if !ok {
+		f.warn(position, "binding source is not a direct call to genjector package")
+		return nil, nil
+	}
+
+	name, typeArguments := f.genjectorCall(source)
+	if len(typeArguments) == 0 {
+		typeArguments = bindArguments
+	}
+	if len(typeArguments) == 0 {
+		return nil, fmt.Errorf(`%s: type of the key for "%s" must be explicit`, position, name)
+	}
+
+	result := &registration{
+		key:      f.text(typeArguments[0]),
+		imports:  map[string]*ast.ImportSpec{},
+		position: position,
+	}
+	f.collectImports(result, typeArguments[0])
+
+	switch {
+	case name == "AsPointer" && len(typeArguments) == 2:
+		star, ok := typeArguments[1].(*ast.StarExpr)
+		if !ok {
+			return nil, fmt.Errorf(`%s: AsPointer requires a pointer type`, position)
+		}
+		result.kind = kindPointer
+		result.implementation = f.text(star.X)
+		f.collectImports(result, star.X)
+	case name == "AsValue" && len(typeArguments) == 2:
+		result.kind = kindValue
+		result.implementation = f.text(typeArguments[1])
+		f.collectImports(result, typeArguments[1])
+	case name == "AsProvider" && len(source.Args) == 1:
+		result.kind = kindProvider
+	case name == "AsContextProvider" && len(source.Args) == 1:
+		result.kind = kindContextProvider
+	case name == "AsInstance" && len(source.Args) == 1:
+		result.kind = kindInstance
+		result.singleton = true
+	case strings.HasPrefix(name, "AsConstructor") && len(source.Args) == 1:
+		result.kind = kindConstructor
+
+		dependencies, err := f.dependencies(source.Args[0], typeArguments)
+		if err != nil {
+			return nil, fmt.Errorf(`%s: %w`, position, err)
+		}
+		for _, dependency := range dependencies {
+			result.dependencies = append(result.dependencies, f.text(dependency))
+			f.collectImports(result, dependency)
+		}
+	default:
+		f.warn(position, fmt.Sprintf(`binding source "%s" is not supported`, name))
+		return nil, nil
+	}
+
+	if result.kind != kindPointer && result.kind != kindValue {
+		result.expression = f.text(source.Args[0])
+		f.collectImports(result, source.Args[0])
+	}
+
+	for _, argument := range call.Args[1:] {
+		option, ok := argument.(*ast.CallExpr)
+		if !ok {
+			f.warn(position, "binding option is not a direct call to genjector package")
+			return nil, nil
+		}
+
+		switch name, _ := f.genjectorCall(option); name {
+		case "AsSingleton", "AsEagerSingleton":
+			result.singleton = true
+		case "WithAnnotation":
+			literal, ok := option.Args[0].(*ast.BasicLit)
+			if !ok || literal.Kind != token.STRING {
+				return nil, fmt.Errorf(`%s: annotation must be a string literal`, position)
+			}
+			result.annotation, _ = strconv.Unquote(literal.Value)
+		case "WithContainer":
+			result.container = f.text(option.Args[0])
+		default:
+			f.warn(position, fmt.Sprintf(`binding option "%s" is not supported`, name))
+			return nil, nil
+		}
+	}
+
+	return result, nil
+}
+
+// dependencies delivers types of all parameters of the constructor method. They are taken
+// from explicit type arguments, from the function literal, or from the function declared
+// inside the same package.
+func (f *fileParser) dependencies(constructor ast.Expr, typeArguments []ast.Expr) ([]ast.Expr, error) {
+	if len(typeArguments) > 2 {
+		return typeArguments[2:], nil
+	}
+
+	var function *ast.FuncType
+	switch value := constructor.(type) {
+	case *ast.FuncLit:
+		function = value.Type
+	case *ast.Ident:
+		function = f.functions[value.Name]
+	}
+
+	if function == nil {
+		return nil, fmt.Errorf(`types of dependencies for "%s" must be explicit`, f.text(constructor))
+	}
+
+	var result []ast.Expr
+	for _, field := range function.Params.List {
+		count := len(field.Names)
+		if count == 0 {
+			count = 1
+		}
+		for i := 0; i < count; i++ {
+			result = append(result, field.Type)
+		}
+	}
+
+	return result, nil
+}
+
+// collectImports stores all imports used inside the expression.
+func (f *fileParser) collectImports(registration *registration, expression ast.Node) {
+	ast.Inspect(expression, func(node ast.Node) bool {
+		selector, ok := node.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+
+		if identifier, ok := selector.X.(*ast.Ident); ok {
+			if spec, ok := f.imports[identifier.Name]; ok {
+				registration.imports[identifier.Name] = spec
+			}
+		}
+		return true
+	})
+}
+
+// text delivers the source code of the node.
+func (f *fileParser) text(node ast.Node) string {
+	var buffer bytes.Buffer
+	_ = printer.Fprint(&buffer, f.fset, node)
+	return buffer.String()
+}
+
+// warn stores a warning about a registration that is skipped.
+func (f *fileParser) warn(position token.Position, message string) {
+	f.warnings = append(f.warnings, fmt.Sprintf(`%s: %s, registration is skipped`, position, message))
+}
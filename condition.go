@@ -0,0 +1,252 @@
+package genjector
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
+// condition represents a single requirement for the Binding, defined
+// with WithCondition or WithProfile method.
+type condition struct {
+	profiles []string
+	check    func() bool
+}
+
+// matches checks if the condition is fulfilled for the Container.
+func (c condition) matches(container *Container) bool {
+	if c.check != nil {
+		return c.check()
+	}
+
+	for _, profile := range c.profiles {
+		if container.isProfileActive(profile) {
+			return true
+		}
+	}
+	return false
+}
+
+// String delivers a readable description of the condition.
+//
+// It respects fmt.Stringer interface.
+func (c condition) String() string {
+	if c.check != nil {
+		return "condition"
+	}
+	return "profile:" + strings.Join(c.profiles, "|")
+}
+
+// conditionalOption represents a BindingOption that defines a condition for the Binding.
+type conditionalOption interface {
+	condition() condition
+}
+
+// conditionOption is a concrete implementation for BindingOption interface.
+type conditionOption struct {
+	value condition
+}
+
+// Binding returns the same instance of Binding provided as an argument, as the
+// condition is applied by Bind method, after all other instances of BindingOption.
+//
+// It respects BindingOption interface.
+func (*conditionOption) Binding(binding Binding) (Binding, error) {
+	return binding, nil
+}
+
+// Key returns the same instance of Key struct provided as an argument.
+//
+// It respects BindingOption interface.
+func (*conditionOption) Key(key Key) Key {
+	return key
+}
+
+// Container returns the same instance of Container struct provided as an argument.
+//
+// It respects BindingOption interface.
+func (*conditionOption) Container(container *Container) *Container {
+	return container
+}
+
+// condition delivers the inner condition.
+//
+// It respects conditionalOption interface.
+func (o *conditionOption) condition() condition {
+	return o.value
+}
+
+// WithCondition delivers a BindingOption that makes the Binding active only while
+// the method returns true. The method is executed on every execution of NewInstance
+// method, so the result should not change after the application is started.
+//
+// When the Binding is not active, the Binding previously defined for the same Key
+// is used instead. That way, one set of Bind methods can describe all environments.
+// For slices and maps defined with InSlice and InMap methods, only that member is
+// skipped, while members defined before and after it are still used.
+//
+// Example:
+// err := genjector.Bind[CacheInterface](
+//
+//	genjector.AsPointer[CacheInterface, *RedisCache](),
+//	genjector.WithCondition(func() bool {
+//	  return os.Getenv("REDIS_URL") != ""
+//	}),
+//
+// )
+func WithCondition(check func() bool) BindingOption {
+	return &conditionOption{
+		value: condition{
+			check: check,
+		},
+	}
+}
+
+// WithProfile delivers a BindingOption that makes the Binding active only when
+// at least one of the profiles is active inside the Container, as defined with
+// SetActiveProfiles method.
+//
+// When the Binding is not active, the Binding previously defined for the same Key
+// is used instead. That way, one set of Bind methods can describe all environments.
+//
+// Example:
+// err := genjector.Bind[CacheInterface](genjector.AsPointer[CacheInterface, *MemoryCache]())
+// err = genjector.Bind[CacheInterface](genjector.AsPointer[CacheInterface, *RedisCache](), genjector.WithProfile("production"))
+func WithProfile(profiles ...string) BindingOption {
+	return &conditionOption{
+		value: condition{
+			profiles: profiles,
+		},
+	}
+}
+
+// profiles is a struct used for storing all active profiles of a Container.
+//
+// It is safe for concurrent usage.
+type profiles struct {
+	list atomic.Pointer[[]string]
+}
+
+// SetActiveProfiles replaces all active profiles of the Container. Active profiles
+// of the Container are used also by all its child containers, together with their own.
+//
+// Example:
+// customContainer.SetActiveProfiles("test", "dev")
+func (c *Container) SetActiveProfiles(active ...string) {
+	list := append([]string(nil), active...)
+	c.profiles.list.Store(&list)
+}
+
+// SetActiveProfiles replaces all active profiles of the standard internal (global)
+// Container, in the same way as SetActiveProfiles method of the Container.
+//
+// Example:
+// genjector.SetActiveProfiles("production")
+func SetActiveProfiles(active ...string) {
+	global.SetActiveProfiles(active...)
+}
+
+// isProfileActive checks if the profile is active for the Container,
+// or for any of its parents.
+func (c *Container) isProfileActive(profile string) bool {
+	for container := c; container != nil; container = container.parent {
+		list := container.profiles.list.Load()
+		if list == nil {
+			continue
+		}
+
+		for _, active := range *list {
+			if active == profile {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// conditionalBinding is a concrete implementation for Binding interface.
+type conditionalBinding struct {
+	current    Binding
+	previous   Binding
+	conditions []condition
+}
+
+// matches checks if all conditions of the Binding are fulfilled for the Container.
+func (b *conditionalBinding) matches(container *Container) bool {
+	for _, condition := range b.conditions {
+		if !condition.matches(container) {
+			return false
+		}
+	}
+	return true
+}
+
+// String delivers a readable description of all conditions of the Binding.
+//
+// It respects fmt.Stringer interface.
+func (b *conditionalBinding) String() string {
+	descriptions := make([]string, 0, len(b.conditions))
+	for _, condition := range b.conditions {
+		descriptions = append(descriptions, condition.String())
+	}
+	return strings.Join(descriptions, "&")
+}
+
+// selected delivers the latest defined Binding whose conditions are fulfilled
+// for the Container. Binding defined without any condition is always fulfilled.
+func (b *conditionalBinding) selected(container *Container) (Binding, bool) {
+	var binding Binding = b
+	for binding != nil {
+		conditional, ok := binding.(*conditionalBinding)
+		if !ok {
+			return binding, true
+		}
+
+		if conditional.matches(container) {
+			return conditional.current, true
+		}
+		binding = conditional.previous
+	}
+	return nil, false
+}
+
+// Instance delivers the instance of the Binding selected for the Container
+// from the context.Context. In case none of them is active, it returns ErrNotBound.
+//
+// It respects Binding interface.
+func (b *conditionalBinding) Instance(ctx context.Context, initialize bool) (interface{}, error) {
+	resolution := resolutionFromContext(ctx)
+
+	container := resolution.container
+	if container == nil {
+		container = global
+	}
+
+	binding, ok := b.selected(container)
+	if !ok {
+		return nil, fmt.Errorf(`%w for key "%s"`, ErrNotBound, resolution.key)
+	}
+
+	return binding.Instance(ctx, initialize)
+}
+
+// activeFromContext delivers the Binding that takes effect inside the Container
+// from the context.Context, in the same way as active method does.
+func activeFromContext(ctx context.Context, binding Binding) (Binding, bool) {
+	container := resolutionFromContext(ctx).container
+	if container == nil {
+		container = global
+	}
+	return container.active(binding)
+}
+
+// active delivers the Binding that takes effect inside the Container. In case
+// the Binding is defined with conditions, the latest one whose conditions are
+// fulfilled is delivered, or false in case there is no such Binding.
+func (c *Container) active(binding Binding) (Binding, bool) {
+	if conditional, ok := binding.(*conditionalBinding); ok {
+		return conditional.selected(c)
+	}
+	return binding, binding != nil
+}
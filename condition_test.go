@@ -0,0 +1,227 @@
+package genjector
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestWithProfile(t *testing.T) {
+	parent := NewContainer()
+	child := NewChildContainer(parent)
+
+	MustBind[string](AsInstance[string]("default"), WithContainer(parent))
+	MustBind[string](AsInstance[string]("test"), WithProfile("test", "dev"), WithContainer(parent))
+	MustBind[string](AsInstance[string]("production"), WithProfile("production"), WithContainer(parent))
+
+	value, err := NewInstance[string](WithContainer(child))
+	if err != nil || value != "default" {
+		t.Errorf(`expected "default", got: "%s", %v`, value, err)
+	}
+
+	parent.SetActiveProfiles("dev")
+	value, err = NewInstance[string](WithContainer(child))
+	if err != nil || value != "test" {
+		t.Errorf(`expected "test", got: "%s", %v`, value, err)
+	}
+
+	child.SetActiveProfiles("production")
+	value, err = NewInstance[string](WithContainer(child))
+	if err != nil || value != "production" {
+		t.Errorf(`expected "production", got: "%s", %v`, value, err)
+	}
+
+	value, err = NewInstance[string](WithContainer(parent))
+	if err != nil || value != "test" {
+		t.Errorf(`expected "test", got: "%s", %v`, value, err)
+	}
+
+	parent.clean()
+	if parent.isProfileActive("dev") {
+		t.Error("expected no active profiles after clean")
+	}
+}
+
+func TestWithCondition(t *testing.T) {
+	container := NewContainer()
+
+	enabled := false
+	MustBind[int](AsInstance[int](1), WithCondition(func() bool {
+		return enabled
+	}), WithContainer(container))
+	MustBind[int](AsInstance[int](2), WithCondition(func() bool {
+		return enabled
+	}), WithProfile("test"), WithContainer(container))
+
+	if IsBound[int](WithContainer(container)) {
+		t.Error("expected inactive binding not to be bound")
+	}
+
+	_, ok, err := NewOptionalInstance[int](WithContainer(container))
+	if ok || err != nil {
+		t.Errorf(`expected missing instance, got: %v, %v`, ok, err)
+	}
+
+	container.SetStrictMode(true)
+	_, err = NewInstance[int](WithContainer(container))
+	if !errors.Is(err, ErrNotBound) {
+		t.Errorf(`expected ErrNotBound, got: %v`, err)
+	}
+
+	err = container.Validate()
+	if err != nil {
+		t.Errorf(`expected inactive key to be skipped, got: %v`, err)
+	}
+
+	enabled = true
+	value, err := NewInstance[int](WithContainer(container))
+	if err != nil || value != 1 {
+		t.Errorf(`expected 1, got: %d, %v`, value, err)
+	}
+
+	container.SetActiveProfiles("test")
+	value, err = NewInstance[int](WithContainer(container))
+	if err != nil || value != 2 {
+		t.Errorf(`expected 2, got: %d, %v`, value, err)
+	}
+}
+
+func TestWithCondition_replace(t *testing.T) {
+	container := NewContainer()
+	MustBind[int](AsInstance[int](1), WithContainer(container))
+
+	_, err := Replace[int](AsInstance[int](2), WithProfile("test"), WithContainer(container))
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+
+	if IsBound[int](WithContainer(container)) {
+		t.Error("expected replaced binding not to use the previous one")
+	}
+}
+
+func TestWithCondition_singleton(t *testing.T) {
+	container := NewContainer()
+
+	created := 0
+	MustBind[*testDependencyStruct](AsContextProvider[*testDependencyStruct](func(ctx context.Context) (*testDependencyStruct, error) {
+		created++
+		return &testDependencyStruct{}, nil
+	}), AsEagerSingleton(), WithProfile("test"), WithContainer(container))
+
+	err := container.Start(context.Background())
+	if err != nil || created != 0 {
+		t.Errorf(`expected no instances, got: %d, %v`, created, err)
+	}
+
+	container.SetActiveProfiles("test")
+	err = container.Start(context.Background())
+	if err != nil || created != 1 {
+		t.Errorf(`expected 1 instance, got: %d, %v`, created, err)
+	}
+
+	first := MustNewInstance[*testDependencyStruct](WithContainer(container))
+	second := MustNewInstance[*testDependencyStruct](WithContainer(container))
+	if first != second || created != 1 {
+		t.Errorf(`expected the same instance, got: %d instances`, created)
+	}
+}
+
+func Test_conditionalBinding_Instance(t *testing.T) {
+	binding := &conditionalBinding{
+		current: &instanceBinding[int]{instance: 1},
+		conditions: []condition{
+			{profiles: []string{"test"}},
+		},
+	}
+
+	_, err := binding.Instance(context.Background(), true)
+	if !errors.Is(err, ErrNotBound) {
+		t.Errorf(`expected ErrNotBound, got: %v`, err)
+	}
+
+	container := NewContainer()
+	container.SetActiveProfiles("test")
+
+	ctx := &resolutionContext{
+		Context: context.Background(),
+		resolution: resolution{
+			container: container,
+		},
+	}
+
+	instance, err := binding.Instance(ctx, true)
+	if err != nil || instance != 1 {
+		t.Errorf(`expected 1, got: %v, %v`, instance, err)
+	}
+}
+
+func Test_conditionalBinding_String(t *testing.T) {
+	binding := &conditionalBinding{
+		conditions: []condition{
+			{profiles: []string{"test", "dev"}},
+			{check: func() bool { return true }},
+		},
+	}
+
+	expected := "profile:test|dev&condition"
+	if binding.String() != expected {
+		t.Errorf(`expected "%s", got: "%s"`, expected, binding.String())
+	}
+}
+
+func TestContainer_DependencyGraph_conditions(t *testing.T) {
+	container := NewContainer()
+	container.SetActiveProfiles("test")
+
+	MustBind[string](AsInstance[string]("default"), WithContainer(container))
+	MustBind[string](AsInstance[string]("test"), WithProfile("test"), WithContainer(container))
+	MustBind[string](AsInstance[string]("production"), WithProfile("production"), WithContainer(container))
+	MustBind[int](AsConstructor1[int](func(value bool) (int, error) {
+		return 0, nil
+	}), WithProfile("production"), WithContainer(container))
+
+	graph := container.DependencyGraph()
+
+	expected := []GraphNode{
+		{ID: "bool", Type: "bool", Bound: false},
+		{ID: "int", Type: "int", Bound: true, Inactive: true},
+		{ID: "int{profile:production}", Type: "int", Bound: true, Inactive: true},
+		{ID: "string", Type: "string", Bound: true},
+		{ID: "string{default}", Type: "string", Bound: true, Inactive: true},
+		{ID: "string{profile:production}", Type: "string", Bound: true, Inactive: true},
+		{ID: "string{profile:test}", Type: "string", Bound: true},
+	}
+	if len(graph.Nodes) != len(expected) {
+		t.Fatalf(`expected %d nodes, got: %v`, len(expected), graph.Nodes)
+	}
+	for i := range expected {
+		if graph.Nodes[i] != expected[i] {
+			t.Errorf(`expected %v, got: %v`, expected[i], graph.Nodes[i])
+		}
+	}
+
+	edges := []GraphEdge{
+		{From: "int", To: "int{profile:production}", Kind: GraphEdgeAlternative},
+		{From: "int{profile:production}", To: "bool", Kind: GraphEdgeDependency},
+		{From: "string", To: "string{default}", Kind: GraphEdgeAlternative},
+		{From: "string", To: "string{profile:production}", Kind: GraphEdgeAlternative},
+		{From: "string", To: "string{profile:test}", Kind: GraphEdgeAlternative},
+	}
+	if len(graph.Edges) != len(edges) {
+		t.Fatalf(`expected %d edges, got: %v`, len(edges), graph.Edges)
+	}
+	for i := range edges {
+		if graph.Edges[i] != edges[i] {
+			t.Errorf(`expected %v, got: %v`, edges[i], graph.Edges[i])
+		}
+	}
+
+	if !strings.Contains(graph.DOT(), `"int" [label="int", style=dotted];`) {
+		t.Errorf(`expected inactive node in DOT, got: %s`, graph.DOT())
+	}
+	if !strings.Contains(graph.Mermaid(), `n1["int"]:::inactive`) || !strings.Contains(graph.Mermaid(), "classDef inactive") {
+		t.Errorf(`expected inactive node in Mermaid, got: %s`, graph.Mermaid())
+	}
+}
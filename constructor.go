@@ -0,0 +1,211 @@
+package genjector
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// constructorBinding is a concrete implementation for Binding interface.
+type constructorBinding[S any] struct {
+	provider ContextProviderMethod[S]
+	keys     []Key
+}
+
+// Instance executes the same method from inner ContextProviderMethod.
+//
+// It respects Binding interface.
+func (b *constructorBinding[S]) Instance(ctx context.Context, initialize bool) (interface{}, error) {
+	return b.provider.Instance(ctx, initialize)
+}
+
+// dependencies delivers keys of all dependencies of the constructor method.
+//
+// It respects dependentBinding interface.
+func (b *constructorBinding[S]) dependencies() []Key {
+	return b.keys
+}
+
+// newConstructor delivers a BindingSource for a type T, with constructorBinding
+// that declares keys of all its dependencies.
+func newConstructor[T any, S any](provider ContextProviderMethod[S], keys ...Key) BindingSource[T] {
+	return &bindingSource[T]{
+		binding: &constructorBinding[S]{
+			provider: provider,
+			keys:     keys,
+		},
+		keySource: baseKeySource[T]{},
+		static:    reflect.TypeOf((*S)(nil)).Elem(),
+	}
+}
+
+// dependency delivers an instance of type D for a constructor method. It uses
+// the Container where the constructor's Binding is defined, as well as the Scope
+// used for the constructor's instance.
+func dependency[D any](ctx context.Context) (D, error) {
+	instance, err := NewInstanceContext[D](ctx)
+	if err != nil {
+		return instance, fmt.Errorf(`dependency for key "%s" can not be resolved: %w`, baseKeySource[D]{}.Key(), err)
+	}
+
+	return instance, nil
+}
+
+// AsConstructor1 delivers a BindingSource for a type T, by defining a constructor
+// method with one dependency. Dependency of type A is resolved from the same Container
+// where the Binding is defined, right before the constructor method is executed.
+//
+// Example:
+// err := genjector.Bind(genjector.AsConstructor1[ServiceInterface](NewService))
+//
+// BindingSource can be only used as the first argument to Bind method.
+func AsConstructor1[T any, S any, A any](constructor func(A) (S, error)) BindingSource[T] {
+	return newConstructor[T](func(ctx context.Context) (S, error) {
+		var empty S
+
+		a, err := dependency[A](ctx)
+		if err != nil {
+			return empty, err
+		}
+
+		return constructor(a)
+	}, baseKeySource[A]{}.Key())
+}
+
+// AsConstructor2 delivers a BindingSource for a type T, by defining a constructor
+// method with two dependencies. Dependencies of types A and B are resolved from the
+// same Container where the Binding is defined, right before the constructor method
+// is executed.
+//
+// Example:
+// err := genjector.Bind(genjector.AsConstructor2[ServiceInterface](NewService))
+//
+// BindingSource can be only used as the first argument to Bind method.
+func AsConstructor2[T any, S any, A any, B any](constructor func(A, B) (S, error)) BindingSource[T] {
+	return newConstructor[T](func(ctx context.Context) (S, error) {
+		var empty S
+
+		a, err := dependency[A](ctx)
+		if err != nil {
+			return empty, err
+		}
+
+		b, err := dependency[B](ctx)
+		if err != nil {
+			return empty, err
+		}
+
+		return constructor(a, b)
+	}, baseKeySource[A]{}.Key(), baseKeySource[B]{}.Key())
+}
+
+// AsConstructor3 delivers a BindingSource for a type T, by defining a constructor
+// method with three dependencies. Dependencies of types A, B and C are resolved from
+// the same Container where the Binding is defined, right before the constructor method
+// is executed.
+//
+// Example:
+// err := genjector.Bind(genjector.AsConstructor3[ServiceInterface](NewService))
+//
+// BindingSource can be only used as the first argument to Bind method.
+func AsConstructor3[T any, S any, A any, B any, C any](constructor func(A, B, C) (S, error)) BindingSource[T] {
+	return newConstructor[T](func(ctx context.Context) (S, error) {
+		var empty S
+
+		a, err := dependency[A](ctx)
+		if err != nil {
+			return empty, err
+		}
+
+		b, err := dependency[B](ctx)
+		if err != nil {
+			return empty, err
+		}
+
+		c, err := dependency[C](ctx)
+		if err != nil {
+			return empty, err
+		}
+
+		return constructor(a, b, c)
+	}, baseKeySource[A]{}.Key(), baseKeySource[B]{}.Key(), baseKeySource[C]{}.Key())
+}
+
+// AsConstructor4 delivers a BindingSource for a type T, by defining a constructor
+// method with four dependencies. Dependencies of types A, B, C and D are resolved from
+// the same Container where the Binding is defined, right before the constructor method
+// is executed.
+//
+// Example:
+// err := genjector.Bind(genjector.AsConstructor4[ServiceInterface](NewService))
+//
+// BindingSource can be only used as the first argument to Bind method.
+func AsConstructor4[T any, S any, A any, B any, C any, D any](constructor func(A, B, C, D) (S, error)) BindingSource[T] {
+	return newConstructor[T](func(ctx context.Context) (S, error) {
+		var empty S
+
+		a, err := dependency[A](ctx)
+		if err != nil {
+			return empty, err
+		}
+
+		b, err := dependency[B](ctx)
+		if err != nil {
+			return empty, err
+		}
+
+		c, err := dependency[C](ctx)
+		if err != nil {
+			return empty, err
+		}
+
+		d, err := dependency[D](ctx)
+		if err != nil {
+			return empty, err
+		}
+
+		return constructor(a, b, c, d)
+	}, baseKeySource[A]{}.Key(), baseKeySource[B]{}.Key(), baseKeySource[C]{}.Key(), baseKeySource[D]{}.Key())
+}
+
+// AsConstructor5 delivers a BindingSource for a type T, by defining a constructor
+// method with five dependencies. Dependencies of types A, B, C, D and E are resolved
+// from the same Container where the Binding is defined, right before the constructor
+// method is executed.
+//
+// Example:
+// err := genjector.Bind(genjector.AsConstructor5[ServiceInterface](NewService))
+//
+// BindingSource can be only used as the first argument to Bind method.
+func AsConstructor5[T any, S any, A any, B any, C any, D any, E any](constructor func(A, B, C, D, E) (S, error)) BindingSource[T] {
+	return newConstructor[T](func(ctx context.Context) (S, error) {
+		var empty S
+
+		a, err := dependency[A](ctx)
+		if err != nil {
+			return empty, err
+		}
+
+		b, err := dependency[B](ctx)
+		if err != nil {
+			return empty, err
+		}
+
+		c, err := dependency[C](ctx)
+		if err != nil {
+			return empty, err
+		}
+
+		d, err := dependency[D](ctx)
+		if err != nil {
+			return empty, err
+		}
+
+		e, err := dependency[E](ctx)
+		if err != nil {
+			return empty, err
+		}
+
+		return constructor(a, b, c, d, e)
+	}, baseKeySource[A]{}.Key(), baseKeySource[B]{}.Key(), baseKeySource[C]{}.Key(), baseKeySource[D]{}.Key(), baseKeySource[E]{}.Key())
+}
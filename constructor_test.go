@@ -0,0 +1,264 @@
+package genjector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type testDependency interface {
+	Value() string
+}
+
+type testDependencyStruct struct {
+	value string
+}
+
+func (s *testDependencyStruct) Value() string {
+	return s.value
+}
+
+func Test_dependency(t *testing.T) {
+	inner := NewContainer()
+	err := Bind[int](AsInstance[int](10), WithContainer(inner))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+
+	ctx := &resolutionContext{
+		Context: context.Background(),
+		resolution: resolution{
+			container: inner,
+		},
+	}
+
+	number, err := dependency[int](ctx)
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if number != 10 {
+		t.Errorf(`expected 10, got: %v`, number)
+	}
+
+	instance, err := dependency[testDependency](ctx)
+	if err == nil {
+		t.Error("expected error, got nil")
+	}
+	if !strings.Contains(err.Error(), "testDependency") {
+		t.Errorf(`expected error with the key, got: %v`, err)
+	}
+	if instance != nil {
+		t.Errorf(`expected nil, got: %v`, instance)
+	}
+}
+
+func TestAsConstructor1(t *testing.T) {
+	inner := NewContainer()
+	err := Bind[testDependency](AsInstance[testDependency](&testDependencyStruct{
+		value: "a",
+	}), WithContainer(inner))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+
+	err = Bind[*testStruct](AsConstructor1[*testStruct](func(a testDependency) (*testStruct, error) {
+		return &testStruct{
+			a: a.Value(),
+		}, nil
+	}), WithContainer(inner))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+
+	instance, err := NewInstance[*testStruct](WithContainer(inner))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if !reflect.DeepEqual(instance, &testStruct{
+		a: "a",
+	}) {
+		t.Error("instances are different")
+	}
+}
+
+func TestAsConstructor1_interface(t *testing.T) {
+	inner := NewContainer()
+	MustBind[int](AsInstance[int](5), WithContainer(inner))
+
+	newDependency := func(value int) (testDependency, error) {
+		return &testDependencyStruct{
+			value: fmt.Sprint(value),
+		}, nil
+	}
+
+	err := Bind[testDependency](AsConstructor1[testDependency](newDependency), WithContainer(inner))
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+
+	instance, err := NewInstance[testDependency](WithContainer(inner))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if instance.Value() != "5" {
+		t.Errorf(`expected "5", got: "%s"`, instance.Value())
+	}
+
+	err = Bind[*testStruct](AsConstructor1[*testStruct](newDependency), WithContainer(inner))
+	var mismatchErr *TypeMismatchError
+	if !errors.As(err, &mismatchErr) {
+		t.Fatalf(`expected TypeMismatchError, got: %v`, err)
+	}
+	if mismatchErr.Actual != reflect.TypeOf((*testDependency)(nil)).Elem() {
+		t.Errorf(`expected testDependency type, got: %v`, mismatchErr.Actual)
+	}
+}
+
+func TestAsConstructor2(t *testing.T) {
+	inner := NewContainer()
+	err := Bind[testDependency](AsInstance[testDependency](&testDependencyStruct{
+		value: "a",
+	}), WithContainer(inner))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+
+	err = Bind[int](AsInstance[int](5), WithContainer(inner))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+
+	err = Bind[*testStruct](AsConstructor2[*testStruct](func(a testDependency, b int) (*testStruct, error) {
+		return &testStruct{
+			a: a.Value(),
+			b: b,
+		}, nil
+	}), WithContainer(inner))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+
+	instance, err := NewInstance[*testStruct](WithContainer(inner))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if !reflect.DeepEqual(instance, &testStruct{
+		a: "a",
+		b: 5,
+	}) {
+		t.Error("instances are different")
+	}
+}
+
+func TestAsConstructor2_missingDependency(t *testing.T) {
+	inner := NewContainer()
+	err := Bind[*testStruct](AsConstructor2[*testStruct](func(a int, b testDependency) (*testStruct, error) {
+		t.Error("constructor should not be executed")
+		return nil, nil
+	}), WithContainer(inner))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+
+	instance, err := NewInstance[*testStruct](WithContainer(inner))
+	if err == nil {
+		t.Error("expected error, got nil")
+	}
+	if instance != nil {
+		t.Errorf(`expected nil, got: %v`, instance)
+	}
+}
+
+func TestAsConstructor3(t *testing.T) {
+	inner := NewContainer()
+	MustBind[int](AsInstance[int](1), WithContainer(inner))
+	MustBind[string](AsInstance[string]("b"), WithContainer(inner))
+	MustBind[bool](AsInstance[bool](true), WithContainer(inner))
+
+	err := Bind[*testStruct](AsConstructor3[*testStruct](func(a int, b string, c bool) (*testStruct, error) {
+		if !c {
+			return nil, errors.New("error")
+		}
+
+		return &testStruct{
+			a: b,
+			b: a,
+		}, nil
+	}), WithContainer(inner))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+
+	instance, err := NewInstance[*testStruct](WithContainer(inner))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if !reflect.DeepEqual(instance, &testStruct{
+		a: "b",
+		b: 1,
+	}) {
+		t.Error("instances are different")
+	}
+}
+
+func TestAsConstructor4(t *testing.T) {
+	inner := NewContainer()
+	MustBind[int](AsInstance[int](1), WithContainer(inner))
+	MustBind[string](AsInstance[string]("b"), WithContainer(inner))
+	MustBind[bool](AsInstance[bool](true), WithContainer(inner))
+	MustBind[float64](AsInstance[float64](2.0), WithContainer(inner))
+
+	err := Bind[*testStruct](AsConstructor4[*testStruct](func(a int, b string, c bool, d float64) (*testStruct, error) {
+		return &testStruct{
+			a: b,
+			b: a + int(d),
+		}, nil
+	}), WithContainer(inner))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+
+	instance, err := NewInstance[*testStruct](WithContainer(inner))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if !reflect.DeepEqual(instance, &testStruct{
+		a: "b",
+		b: 3,
+	}) {
+		t.Error("instances are different")
+	}
+}
+
+func TestAsConstructor5(t *testing.T) {
+	inner := NewContainer()
+	MustBind[int](AsInstance[int](1), WithContainer(inner))
+	MustBind[string](AsInstance[string]("b"), WithContainer(inner))
+	MustBind[bool](AsInstance[bool](true), WithContainer(inner))
+	MustBind[float64](AsInstance[float64](2.0), WithContainer(inner))
+	MustBind[uint](AsInstance[uint](uint(3)), WithContainer(inner))
+
+	err := Bind[*testStruct](AsConstructor5[*testStruct](func(a int, b string, c bool, d float64, e uint) (*testStruct, error) {
+		return &testStruct{
+			a: b,
+			b: a + int(d) + int(e),
+		}, nil
+	}), WithContainer(inner))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+
+	instance, err := NewInstance[*testStruct](WithContainer(inner))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if !reflect.DeepEqual(instance, &testStruct{
+		a: "b",
+		b: 6,
+	}) {
+		t.Error("instances are different")
+	}
+}
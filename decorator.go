@@ -0,0 +1,109 @@
+package genjector
+
+import (
+	"context"
+	"fmt"
+)
+
+// DecoratorMethod defines a type of a method that wraps an instance
+// of type T, delivered by the previous Binding, with a new instance of
+// the same type T. This method is executed at the time of NewInstance method.
+type DecoratorMethod[T any] func(inner T) (T, error)
+
+// decoratorBinding is a concrete implementation for Binding interface.
+type decoratorBinding[T any] struct {
+	previous  Binding
+	decorator DecoratorMethod[T]
+}
+
+// Instance delivers the instance of type T from the previous Binding,
+// wrapped by the DecoratorMethod. In case initialization is not required,
+// it delivers the instance of the previous Binding without executing
+// the DecoratorMethod.
+//
+// It respects Binding interface.
+func (b *decoratorBinding[T]) Instance(ctx context.Context, initialize bool) (interface{}, error) {
+	instance, err := b.previous.Instance(ctx, initialize)
+	if err != nil || !initialize {
+		return instance, err
+	}
+
+	inner, ok := instance.(T)
+	if !ok {
+		return nil, newTypeMismatchError[T](resolutionFromContext(ctx).key, instance)
+	}
+
+	result, err := b.decorator(inner)
+	if err != nil {
+		return nil, newProviderError(ctx, err)
+	}
+
+	return result, nil
+}
+
+// unwrap delivers the previous Binding.
+//
+// It respects wrappingBinding interface.
+func (b *decoratorBinding[T]) unwrap() Binding {
+	return b.previous
+}
+
+// decoratorBindingSource is a concrete implementation for BindingSource interface.
+type decoratorBindingSource[T any] struct {
+	previous  Binding
+	decorator DecoratorMethod[T]
+	keySource baseKeySource[T]
+}
+
+// Binding returns an instance of a new Binding, that wraps the previous one.
+// In case there is no previous Binding, it returns ErrNotBound.
+//
+// It respects BindingSource interface.
+func (s *decoratorBindingSource[T]) Binding() (Binding, error) {
+	if s.previous == nil {
+		return nil, fmt.Errorf(`decorator requires existing binding: %w`, ErrNotBound)
+	}
+
+	return &decoratorBinding[T]{
+		previous:  s.previous,
+		decorator: s.decorator,
+	}, nil
+}
+
+// SetPrevious stores preceding Binding as a previous one.
+//
+// It respects FollowingBindingSource interface.
+func (s *decoratorBindingSource[T]) SetPrevious(binding Binding) {
+	s.previous = binding
+}
+
+// Key executes the same method from inner KeyOption instance.
+//
+// It respects BindingSource interface.
+func (s *decoratorBindingSource[T]) Key() Key {
+	return s.keySource.Key()
+}
+
+// AsDecorator delivers a BindingSource for a type T, by wrapping the Binding that is
+// already defined for the same type T (and the same annotation). The Binding can be
+// defined in the same Container, or in its parent Container. Every time the instance
+// is requested, the DecoratorMethod receives the instance from the previous Binding
+// and delivers the one that wraps it. To execute the DecoratorMethod only once, it
+// should be used together with AsSingleton method.
+//
+// Example:
+//
+//	err := genjector.Bind(genjector.AsDecorator[RepositoryInterface](func(inner RepositoryInterface) (RepositoryInterface, error) {
+//	  return &LoggingRepository{
+//	    inner: inner,
+//	  }, nil
+//	}))
+//
+// BindingSource can be only used as the first argument to Bind method, after
+// the Binding that should be wrapped is already defined.
+func AsDecorator[T any](decorator DecoratorMethod[T]) BindingSource[T] {
+	return &decoratorBindingSource[T]{
+		decorator: decorator,
+		keySource: baseKeySource[T]{},
+	}
+}
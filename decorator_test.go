@@ -0,0 +1,106 @@
+package genjector
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func Test_decoratorBinding_Instance(t *testing.T) {
+	binding := &decoratorBinding[int]{
+		previous: &instanceBinding[int]{
+			instance: 1,
+		},
+		decorator: func(inner int) (int, error) {
+			return inner + 10, nil
+		},
+	}
+
+	instance, err := binding.Instance(context.Background(), false)
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if instance != 1 {
+		t.Errorf(`expected 1, got: %v`, instance)
+	}
+
+	instance, err = binding.Instance(context.Background(), true)
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if instance != 11 {
+		t.Errorf(`expected 11, got: %v`, instance)
+	}
+
+	if binding.unwrap() != binding.previous {
+		t.Error("expected previous binding")
+	}
+}
+
+func Test_decoratorBinding_Instance_errors(t *testing.T) {
+	binding := &decoratorBinding[int]{
+		previous: &instanceBinding[string]{
+			instance: "value",
+		},
+		decorator: func(inner int) (int, error) {
+			return inner, nil
+		},
+	}
+
+	_, err := binding.Instance(context.Background(), true)
+	var mismatchErr *TypeMismatchError
+	if !errors.As(err, &mismatchErr) {
+		t.Errorf(`expected TypeMismatchError, got: %v`, err)
+	}
+
+	binding = &decoratorBinding[int]{
+		previous: &instanceBinding[int]{
+			instance: 1,
+		},
+		decorator: func(inner int) (int, error) {
+			return 0, errTestInit
+		},
+	}
+
+	_, err = binding.Instance(context.Background(), true)
+	var providerErr *ProviderError
+	if !errors.As(err, &providerErr) || !errors.Is(err, errTestInit) {
+		t.Errorf(`expected ProviderError, got: %v`, err)
+	}
+}
+
+func TestAsDecorator(t *testing.T) {
+	parent := NewContainer()
+	MustBind[string](AsInstance[string]("value"), WithContainer(parent), WithAnnotation("annotation"))
+
+	child := NewChildContainer(parent)
+	MustBind[string](AsDecorator[string](func(inner string) (string, error) {
+		return "first(" + inner + ")", nil
+	}), WithContainer(child), WithAnnotation("annotation"))
+	MustBind[string](AsDecorator[string](func(inner string) (string, error) {
+		return "second(" + inner + ")", nil
+	}), WithContainer(child), WithAnnotation("annotation"))
+
+	instance, err := NewInstance[string](WithContainer(child), WithAnnotation("annotation"))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if instance != "second(first(value))" {
+		t.Errorf(`expected decorated value, got: %s`, instance)
+	}
+
+	instance, err = NewInstance[string](WithContainer(parent), WithAnnotation("annotation"))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if instance != "value" {
+		t.Errorf(`expected value, got: %s`, instance)
+	}
+
+	err = Bind[string](AsDecorator[string](func(inner string) (string, error) {
+		return inner, nil
+	}), WithContainer(child))
+	if !errors.Is(err, ErrNotBound) {
+		t.Errorf(`expected ErrNotBound, got: %v`, err)
+	}
+}
@@ -0,0 +1,87 @@
+package genjector
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+)
+
+// Disposable represents any instance that holds resources which should
+// be released when the Container (or Scope) that created it is closed.
+type Disposable interface {
+	Dispose(ctx context.Context) error
+}
+
+// disposer is a struct used for storing all created instances that
+// respect Disposable or io.Closer interface, in order of their creation.
+//
+// It is safe for concurrent usage.
+type disposer struct {
+	mutex     sync.Mutex
+	instances []interface{}
+}
+
+// track stores the instance in case it respects Disposable or io.Closer interface.
+func (d *disposer) track(instance interface{}) {
+	switch instance.(type) {
+	case Disposable, io.Closer:
+	default:
+		return
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.instances = append(d.instances, instance)
+}
+
+// dispose releases all stored instances in reverse order of their creation,
+// and forgets them. All errors are joined together.
+func (d *disposer) dispose(ctx context.Context) error {
+	d.mutex.Lock()
+	instances := d.instances
+	d.instances = nil
+	d.mutex.Unlock()
+
+	var errs []error
+	for i := len(instances) - 1; i >= 0; i-- {
+		var err error
+		switch instance := instances[i].(type) {
+		case Disposable:
+			err = instance.Dispose(ctx)
+		case io.Closer:
+			err = instance.Close()
+		}
+
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Close releases all singletons created by the Container, which respect
+// Disposable or io.Closer interface. Instances are released in reverse order
+// of their creation, so the ones that depend on others are released first.
+// All errors are joined together.
+//
+// Instances created outside the Container, like the ones provided to
+// AsInstance method, as well as non-singleton instances, are not released.
+// Container should not be used for creating new instances after it is closed.
+//
+// Example:
+// defer customContainer.Close(ctx)
+func (c *Container) Close(ctx context.Context) error {
+	return c.disposer.dispose(ctx)
+}
+
+// Close releases all singletons created by the standard internal (global)
+// Container, in the same way as Close method of the Container.
+//
+// Example:
+// defer genjector.Close(ctx)
+func Close(ctx context.Context) error {
+	return global.Close(ctx)
+}
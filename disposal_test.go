@@ -0,0 +1,207 @@
+package genjector
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type testCloser struct {
+	name   string
+	closed *[]string
+	err    error
+}
+
+func (c *testCloser) Close() error {
+	*c.closed = append(*c.closed, c.name)
+	return c.err
+}
+
+type testDisposable struct {
+	name   string
+	closed *[]string
+	err    error
+}
+
+func (d *testDisposable) Dispose(ctx context.Context) error {
+	*d.closed = append(*d.closed, d.name)
+	return d.err
+}
+
+func Test_disposer_track(t *testing.T) {
+	var closed []string
+	disposer := &disposer{}
+
+	disposer.track(10)
+	disposer.track(&testStruct{})
+	if len(disposer.instances) != 0 {
+		t.Errorf(`expected no instances, got: %v`, disposer.instances)
+	}
+
+	disposer.track(&testCloser{closed: &closed})
+	disposer.track(&testDisposable{closed: &closed})
+	if len(disposer.instances) != 2 {
+		t.Errorf(`expected 2 instances, got: %v`, disposer.instances)
+	}
+}
+
+func Test_disposer_dispose(t *testing.T) {
+	var closed []string
+	disposer := &disposer{}
+
+	firstErr := errors.New("first")
+	thirdErr := errors.New("third")
+
+	disposer.track(&testCloser{name: "first", closed: &closed, err: firstErr})
+	disposer.track(&testDisposable{name: "second", closed: &closed})
+	disposer.track(&testCloser{name: "third", closed: &closed, err: thirdErr})
+
+	err := disposer.dispose(context.Background())
+	if !errors.Is(err, firstErr) || !errors.Is(err, thirdErr) {
+		t.Errorf(`expected joined errors, got: %v`, err)
+	}
+	if !reflect.DeepEqual(closed, []string{"third", "second", "first"}) {
+		t.Errorf(`expected reverse order, got: %v`, closed)
+	}
+
+	err = disposer.dispose(context.Background())
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if len(closed) != 3 {
+		t.Errorf(`expected instances to be released once, got: %v`, closed)
+	}
+}
+
+func TestContainer_Close(t *testing.T) {
+	var closed []string
+	inner := NewContainer()
+
+	err := Bind[*testCloser](AsProvider[*testCloser](func() (*testCloser, error) {
+		return &testCloser{name: "closer", closed: &closed}, nil
+	}), AsSingleton(), WithContainer(inner))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+
+	err = Bind[*testDisposable](AsConstructor1[*testDisposable](func(closer *testCloser) (*testDisposable, error) {
+		return &testDisposable{name: "disposable", closed: &closed}, nil
+	}), AsSingleton(), WithContainer(inner))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+
+	err = Bind[testCloser](AsProvider[testCloser](func() (testCloser, error) {
+		return testCloser{name: "transient", closed: &closed}, nil
+	}), WithContainer(inner))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+
+	_, err = NewInstance[*testDisposable](WithContainer(inner))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+
+	_, err = NewInstance[testCloser](WithContainer(inner))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+
+	err = inner.Close(context.Background())
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if !reflect.DeepEqual(closed, []string{"disposable", "closer"}) {
+		t.Errorf(`expected singletons in reverse order, got: %v`, closed)
+	}
+}
+
+func TestContainer_Close_childContainer(t *testing.T) {
+	var closed []string
+	parent := NewContainer()
+	child := NewChildContainer(parent)
+
+	err := Bind[*testCloser](AsProvider[*testCloser](func() (*testCloser, error) {
+		return &testCloser{name: "parent", closed: &closed}, nil
+	}), AsSingleton(), WithContainer(parent))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+
+	_, err = NewInstance[*testCloser](WithContainer(child))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+
+	err = child.Close(context.Background())
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if len(closed) != 0 {
+		t.Errorf(`expected no released instances, got: %v`, closed)
+	}
+
+	err = parent.Close(context.Background())
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if !reflect.DeepEqual(closed, []string{"parent"}) {
+		t.Errorf(`expected released parent singleton, got: %v`, closed)
+	}
+}
+
+func TestClose(t *testing.T) {
+	Clean()
+	defer Clean()
+
+	var closed []string
+	MustBind[*testCloser](AsProvider[*testCloser](func() (*testCloser, error) {
+		return &testCloser{name: "global", closed: &closed}, nil
+	}), AsSingleton())
+
+	MustNewInstance[*testCloser]()
+
+	err := Close(context.Background())
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if !reflect.DeepEqual(closed, []string{"global"}) {
+		t.Errorf(`expected released global singleton, got: %v`, closed)
+	}
+}
+
+func TestScope_Close_disposable(t *testing.T) {
+	var closed []string
+	inner := NewContainer()
+	scope := inner.NewScope()
+
+	err := Bind[*testDisposable](AsProvider[*testDisposable](func() (*testDisposable, error) {
+		return &testDisposable{name: "scoped", closed: &closed}, nil
+	}), AsScoped(), WithContainer(inner))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+
+	_, err = NewInstance[*testDisposable](InScope(scope))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+
+	err = inner.Close(context.Background())
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if len(closed) != 0 {
+		t.Errorf(`expected no released instances, got: %v`, closed)
+	}
+
+	err = scope.Close(context.Background())
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if !reflect.DeepEqual(closed, []string{"scoped"}) {
+		t.Errorf(`expected released scoped instance, got: %v`, closed)
+	}
+}
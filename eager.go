@@ -0,0 +1,163 @@
+package genjector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// AsEagerSingleton delivers a BindingOption that defines the instance of desired
+// Binding as a singleton, in the same way as AsSingleton method does. Additionally,
+// the instance is created by Start method of the Container, instead of the first
+// execution of NewInstance method.
+//
+// Example:
+// err := genjector.Bind(
+//
+//	genjector.AsConstructor1[DatabaseInterface](NewDatabase),
+//	genjector.AsEagerSingleton(),
+//
+// )
+//
+// AsEagerSingleton should be only used as a BindingOption for Bind method.
+func AsEagerSingleton() BindingOption {
+	return &bindingOption{
+		bindingFunc: func(binding Binding) (Binding, error) {
+			return &singletonBinding{
+				parent: binding,
+				eager:  true,
+			}, nil
+		},
+		keyOption: sameKeyOption{},
+	}
+}
+
+// isEager checks if the Binding, or any Binding wrapped by it, is defined
+// with AsEagerSingleton method.
+func isEager(binding Binding) bool {
+	for binding != nil {
+		if singleton, ok := binding.(*singletonBinding); ok && singleton.eager {
+			return true
+		}
+
+		wrapping, ok := binding.(wrappingBinding)
+		if !ok {
+			return false
+		}
+		binding = wrapping.unwrap()
+	}
+	return false
+}
+
+// startOrder delivers keys of all eager singletons from the Container, in order
+// in which they should be created, so every Key comes after all its dependencies.
+// Dependencies are taken from the Graph of the Container.
+func (c *Container) startOrder() ([]Key, map[string][]string) {
+	graph := c.DependencyGraph()
+
+	dependencies := map[string][]string{}
+	for _, edge := range graph.Edges {
+		dependencies[edge.From] = append(dependencies[edge.From], edge.To)
+	}
+
+	eager := map[string]Key{}
+	for _, key := range c.keys() {
+		binding, _, _ := c.binding(key.Generate())
+		if binding, ok := c.active(binding); ok && isEager(binding) {
+			eager[key.String()] = key
+		}
+	}
+
+	var result []Key
+	visited := map[string]bool{}
+
+	var visit func(identifier string)
+	visit = func(identifier string) {
+		if visited[identifier] {
+			return
+		}
+		visited[identifier] = true
+
+		for _, dependency := range dependencies[identifier] {
+			visit(dependency)
+		}
+
+		if key, ok := eager[identifier]; ok {
+			result = append(result, key)
+		}
+	}
+
+	for _, node := range graph.Nodes {
+		visit(node.ID)
+	}
+
+	return result, dependencies
+}
+
+// failedDependency checks if any of the dependencies of the identifier,
+// directly or through other dependencies, is already failed.
+func failedDependency(identifier string, dependencies map[string][]string, failed map[string]bool, visited map[string]bool) bool {
+	if visited[identifier] {
+		return false
+	}
+	visited[identifier] = true
+
+	for _, dependency := range dependencies[identifier] {
+		if failed[dependency] || failedDependency(dependency, dependencies, failed, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// Start creates all singletons defined with AsEagerSingleton method, which are
+// available inside the Container, including the ones from parent Container.
+// Singletons are created in order of their dependencies, so the ones without
+// dependencies are created first.
+//
+// In case of the error, the singletons that depend on the failed one are not
+// created, while all other singletons are still created, so all errors are
+// joined together.
+//
+// Example:
+// err := customContainer.Start(ctx)
+func (c *Container) Start(ctx context.Context) error {
+	order, dependencies := c.startOrder()
+
+	var errs []error
+	failed := map[string]bool{}
+	for _, key := range order {
+		identifier := key.String()
+		if failedDependency(identifier, dependencies, failed, map[string]bool{}) {
+			failed[identifier] = true
+			continue
+		}
+
+		child := &resolutionContext{
+			Context: ctx,
+			resolution: resolution{
+				container: c,
+				key:       key,
+			},
+		}
+
+		_, err := resolve(child, func() (Binding, error) {
+			return nil, ErrNotBound
+		})
+		if err != nil {
+			failed[identifier] = true
+			errs = append(errs, fmt.Errorf(`start failed for key "%s": %w`, key, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Start creates all singletons defined with AsEagerSingleton method inside the standard
+// internal (global) Container, in the same way as Start method of the Container.
+//
+// Example:
+// err := genjector.Start(ctx)
+func Start(ctx context.Context) error {
+	return global.Start(ctx)
+}
@@ -0,0 +1,97 @@
+package genjector
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_isEager(t *testing.T) {
+	if isEager(&singletonBinding{}) {
+		t.Error("expected lazy singleton")
+	}
+	if !isEager(&singletonBinding{eager: true}) {
+		t.Error("expected eager singleton")
+	}
+	if !isEager(&decoratorBinding[int]{previous: &singletonBinding{eager: true}}) {
+		t.Error("expected wrapped eager singleton")
+	}
+	if isEager(pointerBinding[int]{}) {
+		t.Error("expected transient binding")
+	}
+}
+
+func TestContainer_Start(t *testing.T) {
+	var created []string
+	provider := func(name string) func(ctx context.Context) (string, error) {
+		return func(ctx context.Context) (string, error) {
+			created = append(created, name)
+			return name, nil
+		}
+	}
+
+	parent := NewContainer()
+	MustBind[string](AsContextProvider[string](provider("parent")), AsEagerSingleton(), WithContainer(parent), WithAnnotation("parent"))
+
+	child := NewChildContainer(parent)
+	MustBind[int](AsConstructor1[int](func(value string) (int, error) {
+		created = append(created, "int")
+		return len(value), nil
+	}), AsEagerSingleton(), WithContainer(child))
+	MustBind[string](AsContextProvider[string](provider("string")), AsEagerSingleton(), WithContainer(child))
+	MustBind[bool](AsConstructor1[bool](func(value int) (bool, error) {
+		created = append(created, "bool")
+		return value > 0, nil
+	}), WithContainer(child))
+
+	err := child.Start(context.Background())
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+	if !reflect.DeepEqual(created, []string{"string", "int", "parent"}) {
+		t.Errorf(`expected eager singletons in dependency order, got: %v`, created)
+	}
+
+	value, err := NewInstance[int](WithContainer(child))
+	if err != nil || value != 6 {
+		t.Errorf(`expected 6, got: %v, %v`, value, err)
+	}
+	if len(created) != 3 {
+		t.Errorf(`expected no new instances, got: %v`, created)
+	}
+}
+
+func TestContainer_Start_errors(t *testing.T) {
+	inner := NewContainer()
+	MustBind[string](AsContextProvider[string](func(ctx context.Context) (string, error) {
+		return "", errTestInit
+	}), AsEagerSingleton(), WithContainer(inner))
+	MustBind[bool](AsContextProvider[bool](func(ctx context.Context) (bool, error) {
+		return false, errors.New("bool error")
+	}), AsEagerSingleton(), WithContainer(inner))
+	MustBind[int](AsConstructor1[int](func(value string) (int, error) {
+		t.Error("dependent singleton should not be created")
+		return 0, nil
+	}), AsEagerSingleton(), WithContainer(inner))
+	MustBind[float64](AsContextProvider[float64](func(ctx context.Context) (float64, error) {
+		return 1, nil
+	}), AsEagerSingleton(), WithContainer(inner))
+
+	err := inner.Start(context.Background())
+	if !errors.Is(err, errTestInit) {
+		t.Errorf(`expected init error, got: %v`, err)
+	}
+	if !strings.Contains(err.Error(), "bool error") {
+		t.Errorf(`expected all errors, got: %v`, err)
+	}
+	if strings.Contains(err.Error(), `key "int"`) {
+		t.Errorf(`expected no error for dependent singleton, got: %v`, err)
+	}
+
+	value, ok, err := NewOptionalInstance[float64](WithContainer(inner))
+	if err != nil || !ok || value != 1 {
+		t.Errorf(`expected 1, got: %v, %v, %v`, value, ok, err)
+	}
+}
@@ -0,0 +1,126 @@
+package genjector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// CycleError represents an error that occurs when a Binding requires,
+// directly or through other Binding instances, an instance of itself.
+//
+// Path contains all keys in order of their resolution, starting and
+// ending with the same Key.
+//
+// Cycles are detected immediately when every Binding uses the context.Context
+// for inner calls of NewInstanceContext method. Otherwise, they are detected
+// immediately only for singletons during their first construction, while other
+// ones are detected once the chain of unfinished resolutions becomes suspiciously
+// deep, before the stack overflows.
+type CycleError struct {
+	Path []Key
+}
+
+// Error delivers the complete path of the cycle.
+//
+// It respects error interface.
+func (e *CycleError) Error() string {
+	path := make([]string, 0, len(e.Path))
+	for _, key := range e.Path {
+		path = append(path, key.String())
+	}
+
+	return fmt.Sprintf(`dependency cycle detected: %s`, strings.Join(path, " -> "))
+}
+
+// ErrNotBound is returned when there is no Binding for the Key, and
+// the fallback Binding can not be made for it, like for interfaces.
+var ErrNotBound = errors.New("binding is not defined")
+
+// ErrNoScope is returned when a Binding defined with AsScoped method
+// is used without the Scope.
+var ErrNoScope = errors.New("scoped binding is used without scope")
+
+// ErrScopeClosed is returned when a Scope is used after it is closed.
+var ErrScopeClosed = errors.New("scope is already closed")
+
+// ErrNotInitialized is returned when Lazy or Provider is used without
+// being delivered by NewInstance method.
+var ErrNotInitialized = errors.New("handle is used without initialization")
+
+// ErrModuleInstalled is returned when the same Module is installed
+// into the Container more than once.
+var ErrModuleInstalled = errors.New("module is already installed")
+
+// ErrUnknownName is returned when the Configuration uses an interface or
+// an implementation that is not defined inside the Registry or the Container.
+var ErrUnknownName = errors.New("name is not registered")
+
+// TypeMismatchError represents an error that occurs when an instance
+// delivered by a Binding does not match the type required for the Key.
+//
+// Actual is nil when the delivered instance is nil.
+type TypeMismatchError struct {
+	Key      Key
+	Expected reflect.Type
+	Actual   reflect.Type
+}
+
+// newTypeMismatchError delivers a TypeMismatchError for the Key, where
+// the type T is expected.
+func newTypeMismatchError[T any](key Key, instance interface{}) *TypeMismatchError {
+	return &TypeMismatchError{
+		Key:      key,
+		Expected: reflect.TypeOf((*T)(nil)).Elem(),
+		Actual:   reflect.TypeOf(instance),
+	}
+}
+
+// Error delivers both expected and actual type.
+//
+// It respects error interface.
+func (e *TypeMismatchError) Error() string {
+	return fmt.Sprintf(`binding is not possible for key "%s": expected "%s", got "%s"`, e.Key, typeName(e.Expected), typeName(e.Actual))
+}
+
+// ProviderError represents an error returned from a ProviderMethod,
+// a ContextProviderMethod or an Init method, while the instance for
+// the Key is made.
+type ProviderError struct {
+	Key Key
+	Err error
+}
+
+// newProviderError delivers a ProviderError for the Key that is
+// in the process of initialization.
+func newProviderError(ctx context.Context, err error) error {
+	return newKeyProviderError(resolutionFromContext(ctx).key, err)
+}
+
+// newKeyProviderError delivers a ProviderError for the Key. CycleError is
+// delivered as it is, as it already contains the complete path.
+func newKeyProviderError(key Key, err error) error {
+	var cycleErr *CycleError
+	if errors.As(err, &cycleErr) {
+		return err
+	}
+
+	return &ProviderError{
+		Key: key,
+		Err: err,
+	}
+}
+
+// Error delivers the Key and the inner error.
+//
+// It respects error interface.
+func (e *ProviderError) Error() string {
+	return fmt.Sprintf(`instance for key "%s" can not be provided: %v`, e.Key, e.Err)
+}
+
+// Unwrap delivers the inner error.
+func (e *ProviderError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,106 @@
+package genjector
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCycleError_Error(t *testing.T) {
+	err := &CycleError{
+		Path: []Key{
+			{Value: (*int)(nil)},
+			{Value: (*string)(nil), Annotation: "annotation"},
+			{Value: (*int)(nil)},
+		},
+	}
+
+	expected := `dependency cycle detected: int -> string@annotation -> int`
+	if err.Error() != expected {
+		t.Errorf(`expected "%s", got: "%s"`, expected, err.Error())
+	}
+}
+
+func TestTypeMismatchError_Error(t *testing.T) {
+	err := newTypeMismatchError[int](Key{Value: (*int)(nil), Annotation: "annotation"}, "value")
+
+	expected := `binding is not possible for key "int@annotation": expected "int", got "string"`
+	if err.Error() != expected {
+		t.Errorf(`expected "%s", got: "%s"`, expected, err.Error())
+	}
+
+	err = newTypeMismatchError[testDependency](Key{Value: (*testDependency)(nil)}, nil)
+	if err.Actual != nil {
+		t.Errorf(`expected nil, got: %v`, err.Actual)
+	}
+}
+
+func TestProviderError(t *testing.T) {
+	ctx := &resolutionContext{
+		Context: context.Background(),
+		resolution: resolution{
+			key: Key{Value: (*int)(nil)},
+		},
+	}
+	err := newProviderError(ctx, errTestInit)
+
+	if !errors.Is(err, errTestInit) {
+		t.Errorf(`expected wrapped error, got: %v`, err)
+	}
+
+	expected := `instance for key "int" can not be provided: ` + errTestInit.Error()
+	if err.Error() != expected {
+		t.Errorf(`expected "%s", got: "%s"`, expected, err.Error())
+	}
+}
+
+func TestNewInstance_errors(t *testing.T) {
+	inner := NewContainer()
+	MustBind[int](AsProvider[int](func() (int, error) {
+		return 0, nil
+	}), WithContainer(inner))
+	MustBind[string](AsContextProvider[string](func(ctx context.Context) (string, error) {
+		return "", errTestInit
+	}), WithContainer(inner), WithAnnotation("annotation"))
+	err := inner.modify(func(bindings map[interface{}]Binding) error {
+		bindings[(*bool)(nil)] = ProviderMethod[string](func() (string, error) {
+			return "value", nil
+		})
+		return nil
+	})
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+
+	_, err = NewInstance[testDependency](WithContainer(inner))
+	if !errors.Is(err, ErrNotBound) {
+		t.Errorf(`expected ErrNotBound, got: %v`, err)
+	}
+
+	_, err = NewInstance[string](WithContainer(inner), WithAnnotation("annotation"))
+	var providerErr *ProviderError
+	if !errors.As(err, &providerErr) {
+		t.Fatalf(`expected ProviderError, got: %v`, err)
+	}
+	if providerErr.Key != (Key{Value: (*string)(nil), Annotation: "annotation"}) {
+		t.Errorf(`expected key with annotation, got: %v`, providerErr.Key)
+	}
+	if !errors.Is(err, errTestInit) {
+		t.Errorf(`expected wrapped error, got: %v`, err)
+	}
+
+	_, err = NewInstance[bool](WithContainer(inner))
+	var mismatchErr *TypeMismatchError
+	if !errors.As(err, &mismatchErr) {
+		t.Fatalf(`expected TypeMismatchError, got: %v`, err)
+	}
+	if mismatchErr.Expected != reflect.TypeOf(false) || mismatchErr.Actual != reflect.TypeOf("") {
+		t.Errorf(`expected bool and string types, got: %v and %v`, mismatchErr.Expected, mismatchErr.Actual)
+	}
+
+	err = Bind[int](AsInstance[int]("value"), WithContainer(inner))
+	if !errors.As(err, &mismatchErr) {
+		t.Errorf(`expected TypeMismatchError, got: %v`, err)
+	}
+}
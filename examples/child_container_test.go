@@ -0,0 +1,100 @@
+package examples
+
+import (
+	"testing"
+
+	"github.com/ompluscator/genjector"
+)
+
+type ChildContainerInterface interface {
+	String() string
+}
+
+type ChildContainerStruct struct {
+	value string
+}
+
+func (s *ChildContainerStruct) Init() {
+	s.value = "value provided inside the ChildContainerStruct"
+}
+
+func (s *ChildContainerStruct) String() string {
+	return s.value
+}
+
+func TestAsChildContainer(t *testing.T) {
+	t.Run("Use binding from the parent container when child container does not define it", func(t *testing.T) {
+		parent := genjector.NewContainer()
+		child := genjector.NewChildContainer(parent)
+
+		err := genjector.Bind[ChildContainerInterface](
+			genjector.AsPointer[ChildContainerInterface, *ChildContainerStruct](),
+			genjector.AsSingleton(),
+			genjector.WithContainer(parent),
+		)
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		first, err := genjector.NewInstance[ChildContainerInterface](genjector.WithContainer(child))
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+
+		value := first.String()
+		if value != "value provided inside the ChildContainerStruct" {
+			t.Errorf(`unexpected value received: "%s"`, value)
+		}
+
+		second, err := genjector.NewInstance[ChildContainerInterface](genjector.WithContainer(parent))
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+		if first != second {
+			t.Error("expected the same singleton from parent and child container")
+		}
+	})
+
+	t.Run("Shadow binding from the parent container with binding from child container", func(t *testing.T) {
+		parent := genjector.NewContainer()
+		child := genjector.NewChildContainer(parent)
+
+		err := genjector.Bind[ChildContainerInterface](
+			genjector.AsPointer[ChildContainerInterface, *ChildContainerStruct](),
+			genjector.WithContainer(parent),
+		)
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		err = genjector.Bind[ChildContainerInterface](
+			genjector.AsInstance[ChildContainerInterface](&ChildContainerStruct{
+				value: "value provided inside the child container",
+			}),
+			genjector.WithContainer(child),
+		)
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		instance, err := genjector.NewInstance[ChildContainerInterface](genjector.WithContainer(child))
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+
+		value := instance.String()
+		if value != "value provided inside the child container" {
+			t.Errorf(`unexpected value received: "%s"`, value)
+		}
+
+		instance, err = genjector.NewInstance[ChildContainerInterface](genjector.WithContainer(parent))
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+
+		value = instance.String()
+		if value != "value provided inside the ChildContainerStruct" {
+			t.Errorf(`unexpected value received: "%s"`, value)
+		}
+	})
+}
@@ -0,0 +1,91 @@
+package examples
+
+import (
+	"testing"
+
+	"github.com/ompluscator/genjector"
+)
+
+type ConditionCacheInterface interface {
+	Kind() string
+}
+
+type ConditionMemoryCache struct{}
+
+func (s *ConditionMemoryCache) Kind() string {
+	return "memory"
+}
+
+type ConditionRedisCache struct{}
+
+func (s *ConditionRedisCache) Kind() string {
+	return "redis"
+}
+
+func TestWithProfile(t *testing.T) {
+	t.Run("Bind implementations for active profiles", func(t *testing.T) {
+		genjector.Clean()
+
+		err := genjector.Bind[ConditionCacheInterface](genjector.AsPointer[ConditionCacheInterface, *ConditionMemoryCache]())
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		err = genjector.Bind[ConditionCacheInterface](genjector.AsPointer[ConditionCacheInterface, *ConditionRedisCache](), genjector.WithProfile("production"))
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		instance, err := genjector.NewInstance[ConditionCacheInterface]()
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+		if instance.Kind() != "memory" {
+			t.Errorf(`unexpected value received: "%s"`, instance.Kind())
+		}
+
+		genjector.SetActiveProfiles("production")
+
+		instance, err = genjector.NewInstance[ConditionCacheInterface]()
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+		if instance.Kind() != "redis" {
+			t.Errorf(`unexpected value received: "%s"`, instance.Kind())
+		}
+
+		graph := genjector.DependencyGraph()
+		for _, node := range graph.Nodes {
+			if node.ID == "github.com/ompluscator/genjector/examples.ConditionCacheInterface{default}" && !node.Inactive {
+				t.Error("default binding should be inactive")
+			}
+		}
+	})
+}
+
+func TestWithCondition(t *testing.T) {
+	t.Run("Bind implementations for fulfilled conditions", func(t *testing.T) {
+		genjector.Clean()
+
+		redis := false
+		err := genjector.Bind[ConditionCacheInterface](genjector.AsPointer[ConditionCacheInterface, *ConditionRedisCache](), genjector.WithCondition(func() bool {
+			return redis
+		}))
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		if genjector.IsBound[ConditionCacheInterface]() {
+			t.Error("inactive binding should not be bound")
+		}
+
+		redis = true
+		instance, err := genjector.NewInstance[ConditionCacheInterface]()
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+		if instance.Kind() != "redis" {
+			t.Errorf(`unexpected value received: "%s"`, instance.Kind())
+		}
+	})
+}
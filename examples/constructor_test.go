@@ -0,0 +1,97 @@
+package examples
+
+import (
+	"testing"
+
+	"github.com/ompluscator/genjector"
+)
+
+type ConstructorInterface interface {
+	String() string
+}
+
+type ConstructorDependencyInterface interface {
+	Value() string
+}
+
+type ConstructorDependencyStruct struct{}
+
+func (s *ConstructorDependencyStruct) Value() string {
+	return "value provided inside the ConstructorDependencyStruct"
+}
+
+type ConstructorStruct struct {
+	dependency ConstructorDependencyInterface
+	suffix     string
+}
+
+func NewConstructorStruct(dependency ConstructorDependencyInterface, suffix string) (*ConstructorStruct, error) {
+	return &ConstructorStruct{
+		dependency: dependency,
+		suffix:     suffix,
+	}, nil
+}
+
+func (s *ConstructorStruct) String() string {
+	return s.dependency.Value() + s.suffix
+}
+
+func TestAsConstructor(t *testing.T) {
+	t.Run("Resolve dependencies of the constructor from the custom container", func(t *testing.T) {
+		customContainer := genjector.NewContainer()
+
+		err := genjector.Bind[ConstructorDependencyInterface](
+			genjector.AsPointer[ConstructorDependencyInterface, *ConstructorDependencyStruct](),
+			genjector.WithContainer(customContainer),
+		)
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		err = genjector.Bind[string](
+			genjector.AsInstance[string](" and the constructor"),
+			genjector.WithContainer(customContainer),
+		)
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		err = genjector.Bind[ConstructorInterface](
+			genjector.AsConstructor2[ConstructorInterface](NewConstructorStruct),
+			genjector.WithContainer(customContainer),
+		)
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		instance, err := genjector.NewInstance[ConstructorInterface](genjector.WithContainer(customContainer))
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+
+		value := instance.String()
+		if value != "value provided inside the ConstructorDependencyStruct and the constructor" {
+			t.Errorf(`unexpected value received: "%s"`, value)
+		}
+	})
+
+	t.Run("Return error when dependency of the constructor is missing", func(t *testing.T) {
+		customContainer := genjector.NewContainer()
+
+		err := genjector.Bind[ConstructorInterface](
+			genjector.AsConstructor2[ConstructorInterface](NewConstructorStruct),
+			genjector.WithContainer(customContainer),
+		)
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		instance, err := genjector.NewInstance[ConstructorInterface](genjector.WithContainer(customContainer))
+		if err == nil {
+			t.Error("expected an error, but got nil")
+		}
+		if instance != nil {
+			t.Errorf(`unexpected instance received: "%s"`, instance)
+		}
+	})
+}
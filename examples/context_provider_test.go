@@ -0,0 +1,88 @@
+package examples
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ompluscator/genjector"
+)
+
+type ContextProviderInterface interface {
+	String() string
+}
+
+type ContextProviderStruct struct {
+	value string
+}
+
+func (s *ContextProviderStruct) String() string {
+	return s.value
+}
+
+type contextProviderKey struct{}
+
+func TestAsContextProvider(t *testing.T) {
+	t.Run("Take value from the context passed to NewInstanceContext", func(t *testing.T) {
+		genjector.Clean()
+
+		err := genjector.Bind[ContextProviderInterface](
+			genjector.AsContextProvider[ContextProviderInterface](func(ctx context.Context) (*ContextProviderStruct, error) {
+				return &ContextProviderStruct{
+					value: ctx.Value(contextProviderKey{}).(string),
+				}, nil
+			}),
+		)
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		ctx := context.WithValue(context.Background(), contextProviderKey{}, "value provided inside the context")
+		instance, err := genjector.NewInstanceContext[ContextProviderInterface](ctx)
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+
+		value := instance.String()
+		if value != "value provided inside the context" {
+			t.Errorf(`unexpected value received: "%s"`, value)
+		}
+	})
+
+	t.Run("Return error from the provider when context is canceled", func(t *testing.T) {
+		genjector.Clean()
+
+		err := genjector.Bind[ContextProviderInterface](
+			genjector.AsContextProvider[ContextProviderInterface](func(ctx context.Context) (*ContextProviderStruct, error) {
+				if err := ctx.Err(); err != nil {
+					return nil, err
+				}
+
+				return &ContextProviderStruct{
+					value: "value provided inside the ContextProviderMethod",
+				}, nil
+			}),
+		)
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		instance, err := genjector.NewInstance[ContextProviderInterface]()
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+
+		value := instance.String()
+		if value != "value provided inside the ContextProviderMethod" {
+			t.Errorf(`unexpected value received: "%s"`, value)
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err = genjector.NewInstanceContext[ContextProviderInterface](ctx)
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf(`unexpected error received: "%v"`, err)
+		}
+	})
+}
@@ -0,0 +1,158 @@
+package examples
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ompluscator/genjector"
+)
+
+type CycleFirstStruct struct {
+	second *CycleSecondStruct
+}
+
+func (s *CycleFirstStruct) Init(ctx context.Context) error {
+	var err error
+	s.second, err = genjector.NewInstanceContext[*CycleSecondStruct](ctx)
+	return err
+}
+
+type CycleSecondStruct struct {
+	first *CycleFirstStruct
+}
+
+func (s *CycleSecondStruct) Init(ctx context.Context) error {
+	var err error
+	s.first, err = genjector.NewInstanceContext[*CycleFirstStruct](ctx)
+	return err
+}
+
+type CyclePlainFirstStruct struct {
+	second *CyclePlainSecondStruct
+}
+
+func (s *CyclePlainFirstStruct) Init() error {
+	var err error
+	s.second, err = genjector.NewInstance[*CyclePlainSecondStruct]()
+	return err
+}
+
+type CyclePlainSecondStruct struct {
+	first *CyclePlainFirstStruct
+}
+
+func (s *CyclePlainSecondStruct) Init() error {
+	var err error
+	s.first, err = genjector.NewInstance[*CyclePlainFirstStruct]()
+	return err
+}
+
+type CycleTransientFirstStruct struct {
+	second *CycleTransientSecondStruct
+}
+
+func (s *CycleTransientFirstStruct) Init() error {
+	var err error
+	s.second, err = genjector.NewInstance[*CycleTransientSecondStruct]()
+	return err
+}
+
+type CycleTransientSecondStruct struct {
+	first *CycleTransientFirstStruct
+}
+
+func (s *CycleTransientSecondStruct) Init() error {
+	var err error
+	s.first, err = genjector.NewInstance[*CycleTransientFirstStruct]()
+	return err
+}
+
+func TestCycle(t *testing.T) {
+	t.Run("Return CycleError when structs depend on each other", func(t *testing.T) {
+		genjector.Clean()
+
+		err := genjector.Bind[*CycleFirstStruct](genjector.AsPointer[*CycleFirstStruct, *CycleFirstStruct]())
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		err = genjector.Bind[*CycleSecondStruct](genjector.AsPointer[*CycleSecondStruct, *CycleSecondStruct]())
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		instance, err := genjector.NewInstance[*CycleFirstStruct]()
+		if instance != nil {
+			t.Errorf(`unexpected instance received: "%v"`, instance)
+		}
+
+		var cycleErr *genjector.CycleError
+		if !errors.As(err, &cycleErr) {
+			t.Errorf(`unexpected error received: "%v"`, err)
+		}
+		if len(cycleErr.Path) != 3 {
+			t.Errorf(`unexpected path received: "%v"`, cycleErr.Path)
+		}
+	})
+	t.Run("Return CycleError when singletons depend on each other without context", func(t *testing.T) {
+		genjector.Clean()
+
+		err := genjector.Bind[*CyclePlainFirstStruct](genjector.AsPointer[*CyclePlainFirstStruct, *CyclePlainFirstStruct](), genjector.AsSingleton())
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		err = genjector.Bind[*CyclePlainSecondStruct](genjector.AsPointer[*CyclePlainSecondStruct, *CyclePlainSecondStruct]())
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		done := make(chan error)
+		go func() {
+			_, err := genjector.NewInstance[*CyclePlainFirstStruct]()
+			done <- err
+		}()
+
+		select {
+		case err = <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("initialization should not block")
+		}
+
+		var cycleErr *genjector.CycleError
+		if !errors.As(err, &cycleErr) {
+			t.Fatalf(`unexpected error received: "%v"`, err)
+		}
+		if len(cycleErr.Path) != 3 {
+			t.Errorf(`unexpected path received: "%v"`, cycleErr.Path)
+		}
+	})
+	t.Run("Return CycleError when structs depend on each other without context", func(t *testing.T) {
+		genjector.Clean()
+
+		err := genjector.Bind[*CycleTransientFirstStruct](genjector.AsPointer[*CycleTransientFirstStruct, *CycleTransientFirstStruct]())
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		err = genjector.Bind[*CycleTransientSecondStruct](genjector.AsPointer[*CycleTransientSecondStruct, *CycleTransientSecondStruct]())
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		instance, err := genjector.NewInstance[*CycleTransientFirstStruct]()
+		if instance != nil {
+			t.Errorf(`unexpected instance received: "%v"`, instance)
+		}
+
+		var cycleErr *genjector.CycleError
+		if !errors.As(err, &cycleErr) {
+			t.Fatalf(`unexpected error received: "%v"`, err)
+		}
+		if len(cycleErr.Path) != 3 || cycleErr.Path[0] != cycleErr.Path[2] {
+			t.Errorf(`unexpected path received: "%v"`, cycleErr.Path)
+		}
+	})
+}
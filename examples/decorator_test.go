@@ -0,0 +1,55 @@
+package examples
+
+import (
+	"testing"
+
+	"github.com/ompluscator/genjector"
+)
+
+type DecoratorInterface interface {
+	String() string
+}
+
+type DecoratorStruct struct{}
+
+func (s *DecoratorStruct) String() string {
+	return "value"
+}
+
+type LoggingDecoratorStruct struct {
+	inner DecoratorInterface
+}
+
+func (s *LoggingDecoratorStruct) String() string {
+	return "logged " + s.inner.String()
+}
+
+func TestAsDecorator(t *testing.T) {
+	t.Run("Wrap previous Binding with decorator", func(t *testing.T) {
+		genjector.Clean()
+
+		err := genjector.Bind[DecoratorInterface](genjector.AsPointer[DecoratorInterface, *DecoratorStruct]())
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		err = genjector.Bind[DecoratorInterface](genjector.AsDecorator[DecoratorInterface](func(inner DecoratorInterface) (DecoratorInterface, error) {
+			return &LoggingDecoratorStruct{
+				inner: inner,
+			}, nil
+		}), genjector.AsSingleton())
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		instance, err := genjector.NewInstance[DecoratorInterface]()
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+
+		value := instance.String()
+		if value != "logged value" {
+			t.Errorf(`unexpected value received: "%s"`, value)
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package examples
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ompluscator/genjector"
+)
+
+type EagerInterface interface {
+	Connected() bool
+}
+
+type EagerStruct struct {
+	connected bool
+}
+
+func (s *EagerStruct) Init(ctx context.Context) error {
+	s.connected = true
+	return nil
+}
+
+func (s *EagerStruct) Connected() bool {
+	return s.connected
+}
+
+func TestAsEagerSingleton(t *testing.T) {
+	t.Run("Create eager singletons on Start", func(t *testing.T) {
+		genjector.Clean()
+
+		created := 0
+		err := genjector.Bind[EagerInterface](genjector.AsContextProvider[EagerInterface](func(ctx context.Context) (*EagerStruct, error) {
+			created++
+			instance := &EagerStruct{}
+			return instance, instance.Init(ctx)
+		}), genjector.AsEagerSingleton())
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		err = genjector.Start(context.Background())
+		if err != nil {
+			t.Error("start should not cause an error")
+		}
+		if created != 1 {
+			t.Errorf(`unexpected number of instances created: %d`, created)
+		}
+
+		instance, err := genjector.NewInstance[EagerInterface]()
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+		if !instance.Connected() {
+			t.Error("instance should be initialized")
+		}
+		if created != 1 {
+			t.Errorf(`unexpected number of instances created: %d`, created)
+		}
+	})
+}
@@ -0,0 +1,54 @@
+package examples
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ompluscator/genjector"
+)
+
+type ErrorsInterface interface {
+	Value() string
+}
+
+var errProvider = errors.New("provider error")
+
+func TestErrors(t *testing.T) {
+	t.Run("Return ErrNotBound for interface without Binding", func(t *testing.T) {
+		genjector.Clean()
+
+		_, err := genjector.NewInstance[ErrorsInterface]()
+		if !errors.Is(err, genjector.ErrNotBound) {
+			t.Errorf(`unexpected error received: "%v"`, err)
+		}
+	})
+	t.Run("Return ProviderError that wraps error from the provider", func(t *testing.T) {
+		genjector.Clean()
+
+		err := genjector.Bind[ErrorsInterface](genjector.AsProvider[ErrorsInterface](func() (ErrorsInterface, error) {
+			return nil, errProvider
+		}))
+		var providerErr *genjector.ProviderError
+		if !errors.As(err, &providerErr) {
+			t.Errorf(`unexpected error received: "%v"`, err)
+		}
+		if providerErr.Key.Value != (*ErrorsInterface)(nil) {
+			t.Errorf(`unexpected key received: "%v"`, providerErr.Key)
+		}
+		if !errors.Is(err, errProvider) {
+			t.Errorf(`unexpected error received: "%v"`, err)
+		}
+	})
+	t.Run("Return TypeMismatchError for instance of invalid type", func(t *testing.T) {
+		genjector.Clean()
+
+		err := genjector.Bind[ErrorsInterface](genjector.AsInstance[ErrorsInterface]("value"))
+		var mismatchErr *genjector.TypeMismatchError
+		if !errors.As(err, &mismatchErr) {
+			t.Errorf(`unexpected error received: "%v"`, err)
+		}
+		if mismatchErr.Actual.Kind().String() != "string" {
+			t.Errorf(`unexpected type received: "%v"`, mismatchErr.Actual)
+		}
+	})
+}
@@ -0,0 +1,66 @@
+// Package generated shows how the same Binding declarations are used both
+// with the genjector.Container and with the resolver generated by genjector-gen.
+package generated
+
+//go:generate go run ../../cmd/genjector-gen -type Resolver -output genjector_gen.go
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ompluscator/genjector"
+)
+
+type ConfigStruct struct {
+	Name string
+}
+
+func (c *ConfigStruct) Init() {
+	c.Name = "generated"
+}
+
+type RepositoryInterface interface {
+	Find(id int) (string, error)
+}
+
+type RepositoryStruct struct {
+	config *ConfigStruct
+}
+
+func (r *RepositoryStruct) Find(id int) (string, error) {
+	if id <= 0 {
+		return "", errors.New("invalid id")
+	}
+	return r.config.Name, nil
+}
+
+func NewRepository(config *ConfigStruct) (*RepositoryStruct, error) {
+	return &RepositoryStruct{
+		config: config,
+	}, nil
+}
+
+type ServiceStruct struct {
+	Repository RepositoryInterface
+	Timeout    int
+}
+
+func NewTimeout(ctx context.Context) (int, error) {
+	return 30, ctx.Err()
+}
+
+// Register defines all Binding instances inside the Container.
+func Register(container *genjector.Container) error {
+	return errors.Join(
+		genjector.Bind[*ConfigStruct](genjector.AsPointer[*ConfigStruct, *ConfigStruct](), genjector.AsSingleton(), genjector.WithContainer(container)),
+		genjector.Bind[RepositoryInterface](genjector.AsConstructor1[RepositoryInterface](NewRepository), genjector.WithContainer(container)),
+		genjector.Bind[int](genjector.AsContextProvider[int](NewTimeout), genjector.WithContainer(container), genjector.WithAnnotation("timeout")),
+		genjector.Bind[string](genjector.AsInstance[string]("primary"), genjector.WithContainer(container), genjector.WithAnnotation("name")),
+		genjector.Bind[*ServiceStruct](genjector.AsConstructor1[*ServiceStruct](func(repository RepositoryInterface) (*ServiceStruct, error) {
+			return &ServiceStruct{
+				Repository: repository,
+				Timeout:    30,
+			}, nil
+		}), genjector.AsSingleton(), genjector.WithContainer(container)),
+	)
+}
@@ -0,0 +1,64 @@
+package generated
+
+import (
+	"testing"
+
+	"github.com/ompluscator/genjector"
+)
+
+func TestResolver(t *testing.T) {
+	t.Run("Deliver the same instances as the Container", func(t *testing.T) {
+		container := genjector.NewContainer()
+		err := Register(container)
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		resolver := NewResolver()
+
+		expected, err := genjector.NewInstance[*ServiceStruct](genjector.WithContainer(container))
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+
+		instance, err := resolver.ServiceStruct()
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+
+		expectedName, _ := expected.Repository.Find(1)
+		name, _ := instance.Repository.Find(1)
+		if name != expectedName || name != "generated" {
+			t.Errorf(`unexpected value received: "%s"`, name)
+		}
+
+		again, err := resolver.ServiceStruct()
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+		if again != instance {
+			t.Error("singleton should deliver the same instance")
+		}
+
+		timeout, err := resolver.IntTimeout()
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+
+		expectedTimeout, err := genjector.NewInstance[int](genjector.WithContainer(container), genjector.WithAnnotation("timeout"))
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+		if timeout != expectedTimeout {
+			t.Errorf(`unexpected value received: "%d"`, timeout)
+		}
+
+		value, err := resolver.StringName()
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+		if value != "primary" {
+			t.Errorf(`unexpected value received: "%s"`, value)
+		}
+	})
+}
@@ -0,0 +1,169 @@
+// Code generated by genjector-gen. DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ompluscator/genjector"
+)
+
+// Resolver delivers instances for all keys bound in the package,
+// without using the genjector.Container.
+//
+// It is safe for concurrent usage.
+type Resolver struct {
+	configStructMutex  sync.Mutex
+	configStructDone   bool
+	configStruct       *ConfigStruct
+	serviceStructMutex sync.Mutex
+	serviceStructDone  bool
+	serviceStruct      *ServiceStruct
+	stringNameMutex    sync.Mutex
+	stringNameDone     bool
+	stringName         string
+}
+
+// NewResolver delivers a new instance of Resolver.
+func NewResolver() *Resolver {
+	return &Resolver{}
+}
+
+// ConfigStruct delivers the singleton instance for the key "*ConfigStruct".
+func (r *Resolver) ConfigStruct() (*ConfigStruct, error) {
+	r.configStructMutex.Lock()
+	defer r.configStructMutex.Unlock()
+
+	if r.configStructDone {
+		return r.configStruct, nil
+	}
+
+	instance, err := r.newConfigStruct()
+	if err != nil {
+		return instance, err
+	}
+
+	r.configStruct = instance
+	r.configStructDone = true
+	return instance, nil
+}
+
+// newConfigStruct makes the instance for the key "*ConfigStruct".
+func (r *Resolver) newConfigStruct() (*ConfigStruct, error) {
+	var empty *ConfigStruct
+
+	instance := &ConfigStruct{}
+	if err := r.initialize(instance); err != nil {
+		return empty, err
+	}
+
+	return instance, nil
+}
+
+// IntTimeout delivers a new instance for the key "int" with annotation "timeout".
+func (r *Resolver) IntTimeout() (int, error) {
+	var empty int
+
+	instance, err := NewTimeout(context.Background())
+	if err != nil {
+		return empty, err
+	}
+
+	return instance, nil
+}
+
+// RepositoryInterface delivers a new instance for the key "RepositoryInterface".
+func (r *Resolver) RepositoryInterface() (RepositoryInterface, error) {
+	var empty RepositoryInterface
+
+	dependency0, err := r.ConfigStruct()
+	if err != nil {
+		return empty, err
+	}
+
+	instance, err := NewRepository(dependency0)
+	if err != nil {
+		return empty, err
+	}
+
+	return instance, nil
+}
+
+// ServiceStruct delivers the singleton instance for the key "*ServiceStruct".
+func (r *Resolver) ServiceStruct() (*ServiceStruct, error) {
+	r.serviceStructMutex.Lock()
+	defer r.serviceStructMutex.Unlock()
+
+	if r.serviceStructDone {
+		return r.serviceStruct, nil
+	}
+
+	instance, err := r.newServiceStruct()
+	if err != nil {
+		return instance, err
+	}
+
+	r.serviceStruct = instance
+	r.serviceStructDone = true
+	return instance, nil
+}
+
+// newServiceStruct makes the instance for the key "*ServiceStruct".
+func (r *Resolver) newServiceStruct() (*ServiceStruct, error) {
+	var empty *ServiceStruct
+
+	dependency0, err := r.RepositoryInterface()
+	if err != nil {
+		return empty, err
+	}
+
+	instance, err := (func(repository RepositoryInterface) (*ServiceStruct, error) {
+		return &ServiceStruct{
+			Repository: repository,
+			Timeout:    30,
+		}, nil
+	})(dependency0)
+	if err != nil {
+		return empty, err
+	}
+
+	return instance, nil
+}
+
+// StringName delivers the singleton instance for the key "string" with annotation "name".
+func (r *Resolver) StringName() (string, error) {
+	r.stringNameMutex.Lock()
+	defer r.stringNameMutex.Unlock()
+
+	if r.stringNameDone {
+		return r.stringName, nil
+	}
+
+	instance, err := r.newStringName()
+	if err != nil {
+		return instance, err
+	}
+
+	r.stringName = instance
+	r.stringNameDone = true
+	return instance, nil
+}
+
+// newStringName makes the instance for the key "string" with annotation "name".
+func (r *Resolver) newStringName() (string, error) {
+	return "primary", nil
+}
+
+// initialize executes Init method of the instance, in the same way as genjector.Container does.
+func (*Resolver) initialize(instance interface{}) error {
+	switch value := instance.(type) {
+	case genjector.Initializable:
+		value.Init()
+	case genjector.InitializableWithError:
+		return value.Init()
+	case genjector.InitializableWithContext:
+		return value.Init(context.Background())
+	}
+	return nil
+}
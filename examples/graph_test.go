@@ -0,0 +1,62 @@
+package examples
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ompluscator/genjector"
+)
+
+type GraphDependencyInterface interface {
+	Value() string
+}
+
+type GraphDependencyStruct struct{}
+
+func (s *GraphDependencyStruct) Value() string {
+	return "value"
+}
+
+type GraphServiceStruct struct {
+	dependency GraphDependencyInterface
+}
+
+func NewGraphService(dependency GraphDependencyInterface) (*GraphServiceStruct, error) {
+	return &GraphServiceStruct{
+		dependency: dependency,
+	}, nil
+}
+
+func TestDependencyGraph(t *testing.T) {
+	t.Run("Export dependencies declared by constructor", func(t *testing.T) {
+		genjector.Clean()
+
+		err := genjector.Bind[GraphDependencyInterface](genjector.AsPointer[GraphDependencyInterface, *GraphDependencyStruct]())
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		err = genjector.Bind[*GraphServiceStruct](genjector.AsConstructor1[*GraphServiceStruct](NewGraphService))
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		graph := genjector.DependencyGraph()
+		if len(graph.Nodes) != 2 {
+			t.Errorf(`unexpected nodes received: "%v"`, graph.Nodes)
+		}
+		if len(graph.Edges) != 1 {
+			t.Errorf(`unexpected edges received: "%v"`, graph.Edges)
+		}
+
+		dot := graph.DOT()
+		if !strings.Contains(dot, `"*github.com/ompluscator/genjector/examples.GraphServiceStruct" -> "github.com/ompluscator/genjector/examples.GraphDependencyInterface";`) {
+			t.Errorf(`unexpected DOT received: "%s"`, dot)
+		}
+
+		mermaid := graph.Mermaid()
+		if !strings.Contains(mermaid, "n0 --> n1") {
+			t.Errorf(`unexpected Mermaid received: "%s"`, mermaid)
+		}
+	})
+}
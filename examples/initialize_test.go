@@ -0,0 +1,48 @@
+package examples
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ompluscator/genjector"
+)
+
+var errMissingConfiguration = errors.New("missing configuration")
+
+type InitializeInterface interface {
+	String() string
+}
+
+type InitializeStruct struct {
+	value string
+}
+
+func (s *InitializeStruct) Init() error {
+	if s.value == "" {
+		return errMissingConfiguration
+	}
+	return nil
+}
+
+func (s *InitializeStruct) String() string {
+	return s.value
+}
+
+func TestAsPointerWithError(t *testing.T) {
+	t.Run("Return the error from Init method of a pointer to a struct", func(t *testing.T) {
+		genjector.Clean()
+
+		err := genjector.Bind[InitializeInterface](genjector.AsPointer[InitializeInterface, *InitializeStruct]())
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		instance, err := genjector.NewInstance[InitializeInterface]()
+		if !errors.Is(err, errMissingConfiguration) {
+			t.Errorf(`unexpected error received: "%v"`, err)
+		}
+		if instance != nil {
+			t.Errorf(`unexpected instance received: "%s"`, instance)
+		}
+	})
+}
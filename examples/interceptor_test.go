@@ -0,0 +1,51 @@
+package examples
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ompluscator/genjector"
+)
+
+type InterceptedInterface interface {
+	String() string
+}
+
+type InterceptedStruct struct{}
+
+func (s *InterceptedStruct) String() string {
+	return "value"
+}
+
+func TestAddInterceptor(t *testing.T) {
+	t.Run("Observe every instance with interceptor", func(t *testing.T) {
+		genjector.Clean()
+
+		err := genjector.Bind[InterceptedInterface](genjector.AsPointer[InterceptedInterface, *InterceptedStruct](), genjector.AsSingleton())
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		var invocations []genjector.Invocation
+		genjector.AddInterceptor(func(ctx context.Context, invocation *genjector.Invocation, next func(context.Context) (interface{}, error)) (interface{}, error) {
+			instance, err := next(ctx)
+			invocations = append(invocations, *invocation)
+			return instance, err
+		})
+
+		_, err = genjector.NewInstance[InterceptedInterface]()
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+
+		if len(invocations) != 1 {
+			t.Fatalf(`unexpected invocations received: "%v"`, invocations)
+		}
+		if invocations[0].Lifetime != genjector.LifetimeSingleton {
+			t.Errorf(`unexpected lifetime received: "%s"`, invocations[0].Lifetime)
+		}
+		if invocations[0].Key.String() != "github.com/ompluscator/genjector/examples.InterceptedInterface" {
+			t.Errorf(`unexpected key received: "%s"`, invocations[0].Key)
+		}
+	})
+}
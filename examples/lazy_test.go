@@ -0,0 +1,89 @@
+package examples
+
+import (
+	"testing"
+
+	"github.com/ompluscator/genjector"
+)
+
+type LazyInterface interface {
+	String() string
+}
+
+type LazyStruct struct {
+	value string
+}
+
+func (s *LazyStruct) Init() {
+	s.value = "value provided inside the LazyStruct"
+}
+
+func (s *LazyStruct) String() string {
+	return s.value
+}
+
+type LazyServiceStruct struct {
+	dependency genjector.Lazy[LazyInterface]
+}
+
+func TestLazy(t *testing.T) {
+	t.Run("Resolve dependency on the first usage", func(t *testing.T) {
+		genjector.Clean()
+
+		err := genjector.Bind[LazyInterface](genjector.AsPointer[LazyInterface, *LazyStruct]())
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		err = genjector.Bind[*LazyServiceStruct](genjector.AsConstructor1[*LazyServiceStruct](func(dependency genjector.Lazy[LazyInterface]) (*LazyServiceStruct, error) {
+			return &LazyServiceStruct{
+				dependency: dependency,
+			}, nil
+		}))
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		instance, err := genjector.NewInstance[*LazyServiceStruct]()
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+
+		dependency, err := instance.dependency.Get()
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+
+		value := dependency.String()
+		if value != "value provided inside the LazyStruct" {
+			t.Errorf(`unexpected value received: "%s"`, value)
+		}
+	})
+	t.Run("Resolve dependency on every usage", func(t *testing.T) {
+		genjector.Clean()
+
+		err := genjector.Bind[LazyInterface](genjector.AsPointer[LazyInterface, *LazyStruct]())
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		provider, err := genjector.NewInstance[genjector.Provider[LazyInterface]]()
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+
+		first, err := provider.Get()
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+
+		second, err := provider.Get()
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+
+		if first == second {
+			t.Error("instances should be different")
+		}
+	})
+}
@@ -0,0 +1,65 @@
+package examples
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ompluscator/genjector"
+)
+
+type ModuleRepositoryInterface interface {
+	Name() string
+}
+
+type ModuleRepositoryStruct struct{}
+
+func (s *ModuleRepositoryStruct) Name() string {
+	return "repository"
+}
+
+type ModuleServiceStruct struct {
+	Repository ModuleRepositoryInterface
+}
+
+var ModuleRepository = genjector.NewModule(
+	"repository",
+	genjector.WithBinding[ModuleRepositoryInterface](genjector.AsPointer[ModuleRepositoryInterface, *ModuleRepositoryStruct](), genjector.AsSingleton()),
+)
+
+var ModuleService = genjector.NewModule(
+	"service",
+	genjector.WithModules(ModuleRepository),
+	genjector.WithBinding[*ModuleServiceStruct](genjector.AsConstructor1[*ModuleServiceStruct](func(repository ModuleRepositoryInterface) (*ModuleServiceStruct, error) {
+		return &ModuleServiceStruct{
+			Repository: repository,
+		}, nil
+	})),
+)
+
+func TestInstall(t *testing.T) {
+	t.Run("Install a Module with its included modules", func(t *testing.T) {
+		container := genjector.NewContainer()
+
+		err := genjector.Install(container, ModuleService)
+		if err != nil {
+			t.Error("install should not cause an error")
+		}
+
+		instance, err := genjector.NewInstance[*ModuleServiceStruct](genjector.WithContainer(container))
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+		if instance.Repository.Name() != "repository" {
+			t.Errorf(`unexpected value received: "%s"`, instance.Repository.Name())
+		}
+	})
+
+	t.Run("Detect a Module installed twice", func(t *testing.T) {
+		container := genjector.NewContainer()
+
+		err := genjector.Install(container, ModuleService, ModuleRepository)
+		if !errors.Is(err, genjector.ErrModuleInstalled) {
+			t.Errorf(`unexpected error received: %v`, err)
+		}
+	})
+}
@@ -0,0 +1,56 @@
+package examples
+
+import (
+	"testing"
+
+	"github.com/ompluscator/genjector"
+)
+
+type OptionalInterface interface {
+	String() string
+}
+
+type OptionalStruct struct{}
+
+func (s *OptionalStruct) String() string {
+	return "value provided inside the OptionalStruct"
+}
+
+func TestNewOptionalInstance(t *testing.T) {
+	t.Run("Return no instance when Binding is not defined", func(t *testing.T) {
+		genjector.Clean()
+
+		if genjector.IsBound[OptionalInterface]() {
+			t.Error("binding should not be defined")
+		}
+
+		instance, ok, err := genjector.NewOptionalInstance[OptionalInterface]()
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+		if ok || instance != nil {
+			t.Errorf(`unexpected instance received: "%v"`, instance)
+		}
+	})
+	t.Run("Return instance when Binding is defined", func(t *testing.T) {
+		genjector.Clean()
+
+		err := genjector.Bind[OptionalInterface](genjector.AsPointer[OptionalInterface, *OptionalStruct]())
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		instance, ok, err := genjector.NewOptionalInstance[OptionalInterface]()
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+		if !ok {
+			t.Error("instance should be delivered")
+		}
+
+		value := instance.String()
+		if value != "value provided inside the OptionalStruct" {
+			t.Errorf(`unexpected value received: "%s"`, value)
+		}
+	})
+}
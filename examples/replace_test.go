@@ -0,0 +1,59 @@
+package examples
+
+import (
+	"testing"
+
+	"github.com/ompluscator/genjector"
+)
+
+type ReplaceInterface interface {
+	String() string
+}
+
+type ReplaceStruct struct{}
+
+func (s *ReplaceStruct) String() string {
+	return "value provided inside the ReplaceStruct"
+}
+
+type OtherReplaceStruct struct{}
+
+func (s *OtherReplaceStruct) String() string {
+	return "value provided inside the OtherReplaceStruct"
+}
+
+func TestReplace(t *testing.T) {
+	t.Run("Replace and unbind the Binding", func(t *testing.T) {
+		genjector.Clean()
+
+		err := genjector.Bind[ReplaceInterface](genjector.AsPointer[ReplaceInterface, *ReplaceStruct]())
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		replaced, err := genjector.Replace[ReplaceInterface](genjector.AsPointer[ReplaceInterface, *OtherReplaceStruct]())
+		if err != nil {
+			t.Error("replacing should not cause an error")
+		}
+		if !replaced {
+			t.Error("binding should be replaced")
+		}
+
+		instance, err := genjector.NewInstance[ReplaceInterface]()
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+
+		value := instance.String()
+		if value != "value provided inside the OtherReplaceStruct" {
+			t.Errorf(`unexpected value received: "%s"`, value)
+		}
+
+		if !genjector.Unbind[ReplaceInterface]() {
+			t.Error("binding should be removed")
+		}
+		if genjector.IsBound[ReplaceInterface]() {
+			t.Error("binding should not be defined")
+		}
+	})
+}
@@ -0,0 +1,101 @@
+package examples
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ompluscator/genjector"
+)
+
+type ScopedInterface interface {
+	String() string
+}
+
+type ScopedStruct struct {
+	value string
+}
+
+func (s *ScopedStruct) Init() {
+	s.value = "value provided inside the ScopedStruct"
+}
+
+func (s *ScopedStruct) String() string {
+	return s.value
+}
+
+func TestAsScoped(t *testing.T) {
+	t.Run("Expecting the same instance inside the scope and different between scopes", func(t *testing.T) {
+		customContainer := genjector.NewContainer()
+
+		err := genjector.Bind[ScopedInterface](
+			genjector.AsPointer[ScopedInterface, *ScopedStruct](),
+			genjector.AsScoped(),
+			genjector.WithContainer(customContainer),
+		)
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		scope := customContainer.NewScope()
+
+		first, err := genjector.NewInstance[ScopedInterface](genjector.InScope(scope))
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+
+		value := first.String()
+		if value != "value provided inside the ScopedStruct" {
+			t.Errorf(`unexpected value received: "%s"`, value)
+		}
+
+		second, err := genjector.NewInstance[ScopedInterface](genjector.InScope(scope))
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+		if first != second {
+			t.Error("expected the same instance inside the same scope")
+		}
+
+		err = scope.Close(context.Background())
+		if err != nil {
+			t.Error("closing should not cause an error")
+		}
+
+		other := customContainer.NewScope()
+		defer other.Close(context.Background())
+
+		third, err := genjector.NewInstance[ScopedInterface](genjector.InScope(other))
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+		if first == third {
+			t.Error("expected different instances inside different scopes")
+		}
+
+		_, err = genjector.NewInstance[ScopedInterface](genjector.InScope(scope))
+		if err == nil {
+			t.Error("expected an error, but got nil")
+		}
+	})
+
+	t.Run("Expecting an error when scoped instance is used without the scope", func(t *testing.T) {
+		customContainer := genjector.NewContainer()
+
+		err := genjector.Bind[ScopedInterface](
+			genjector.AsPointer[ScopedInterface, *ScopedStruct](),
+			genjector.AsScoped(),
+			genjector.WithContainer(customContainer),
+		)
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		instance, err := genjector.NewInstance[ScopedInterface](genjector.WithContainer(customContainer))
+		if err == nil {
+			t.Error("expected an error, but got nil")
+		}
+		if instance != nil {
+			t.Errorf(`unexpected instance received: "%s"`, instance)
+		}
+	})
+}
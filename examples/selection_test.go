@@ -0,0 +1,84 @@
+package examples
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ompluscator/genjector"
+)
+
+type SelectionCacheInterface interface {
+	Kind() string
+}
+
+type SelectionRedisCache struct{}
+
+func (s *SelectionRedisCache) Kind() string {
+	return "redis"
+}
+
+type SelectionMemoryCache struct{}
+
+func (s *SelectionMemoryCache) Kind() string {
+	return "memory"
+}
+
+func TestRegistry(t *testing.T) {
+	t.Run("Select implementations from the configuration", func(t *testing.T) {
+		container := genjector.NewContainer()
+
+		err := genjector.Bind[SelectionCacheInterface](genjector.InMap[string, SelectionCacheInterface]("redis", genjector.AsPointer[SelectionCacheInterface, *SelectionRedisCache]()), genjector.WithContainer(container))
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		err = genjector.Bind[SelectionCacheInterface](genjector.InMap[string, SelectionCacheInterface]("memory", genjector.AsPointer[SelectionCacheInterface, *SelectionMemoryCache]()), genjector.WithContainer(container))
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		registry := genjector.NewRegistry(genjector.Selectable[SelectionCacheInterface]("cache", genjector.AsSingleton()))
+
+		err = registry.Load(container, []byte(`{
+			"bindings": [
+				{"interface": "cache", "implementation": "redis"},
+				{"interface": "cache", "annotation": "sessions", "implementation": "memory"}
+			]
+		}`))
+		if err != nil {
+			t.Error("loading should not cause an error")
+		}
+
+		instance, err := genjector.NewInstance[SelectionCacheInterface](genjector.WithContainer(container))
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+		if instance.Kind() != "redis" {
+			t.Errorf(`unexpected value received: "%s"`, instance.Kind())
+		}
+
+		instance, err = genjector.NewInstance[SelectionCacheInterface](genjector.WithContainer(container), genjector.WithAnnotation("sessions"))
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+		if instance.Kind() != "memory" {
+			t.Errorf(`unexpected value received: "%s"`, instance.Kind())
+		}
+	})
+
+	t.Run("Reject unknown names", func(t *testing.T) {
+		container := genjector.NewContainer()
+
+		err := genjector.Bind[SelectionCacheInterface](genjector.InMap[string, SelectionCacheInterface]("redis", genjector.AsPointer[SelectionCacheInterface, *SelectionRedisCache]()), genjector.WithContainer(container))
+		if err != nil {
+			t.Error("binding should not cause an error")
+		}
+
+		registry := genjector.NewRegistry(genjector.Selectable[SelectionCacheInterface]("cache"))
+
+		err = registry.Load(container, []byte(`{"bindings": [{"interface": "cache", "implementation": "memcached"}]}`))
+		if !errors.Is(err, genjector.ErrUnknownName) {
+			t.Errorf(`unexpected error received: %v`, err)
+		}
+	})
+}
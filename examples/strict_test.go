@@ -0,0 +1,38 @@
+package examples
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ompluscator/genjector"
+)
+
+type StrictStruct struct {
+	value string
+}
+
+func TestStrictMode(t *testing.T) {
+	t.Run("Return ErrNotBound instead of fallback value in strict mode", func(t *testing.T) {
+		genjector.Clean()
+
+		instance, err := genjector.NewInstance[StrictStruct]()
+		if err != nil {
+			t.Error("initialization should not cause an error")
+		}
+		if instance.value != "" {
+			t.Errorf(`unexpected value received: "%s"`, instance.value)
+		}
+
+		_, err = genjector.NewInstance[StrictStruct](genjector.WithStrictMode())
+		if !errors.Is(err, genjector.ErrNotBound) {
+			t.Errorf(`unexpected error received: "%v"`, err)
+		}
+
+		genjector.SetStrictMode(true)
+
+		_, err = genjector.NewInstance[StrictStruct]()
+		if !errors.Is(err, genjector.ErrNotBound) {
+			t.Errorf(`unexpected error received: "%v"`, err)
+		}
+	})
+}
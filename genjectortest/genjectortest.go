@@ -0,0 +1,298 @@
+// Package genjectortest provides helpers for using genjector inside tests.
+//
+// Every test receives its own Container, so tests can run in parallel
+// without calling genjector.Clean method, and all overridden Binding
+// instances are restored automatically at the end of the test.
+package genjectortest
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ompluscator/genjector"
+)
+
+// NewContainer delivers a new instance of Container, which is closed
+// at the end of the test.
+//
+// Example:
+// container := genjectortest.NewContainer(t)
+//
+// err := genjector.Bind(
+//
+//	genjector.AsPointer[TestInterface, *TestStruct](),
+//	genjector.WithContainer(container),
+//
+// )
+func NewContainer(t testing.TB) *genjector.Container {
+	t.Helper()
+
+	return cleanup(t, genjector.NewContainer())
+}
+
+// NewChildContainer delivers a new instance of Container, that uses the
+// parent Container for all Binding instances it does not define itself.
+// It is closed at the end of the test, while the parent Container stays unchanged.
+//
+// Example:
+// container := genjectortest.NewChildContainer(t, applicationContainer)
+func NewChildContainer(t testing.TB, parent *genjector.Container) *genjector.Container {
+	t.Helper()
+
+	return cleanup(t, genjector.NewChildContainer(parent))
+}
+
+// cleanup closes the Container at the end of the test.
+func cleanup(t testing.TB, container *genjector.Container) *genjector.Container {
+	t.Helper()
+
+	t.Cleanup(func() {
+		err := container.Close(context.Background())
+		if err != nil {
+			t.Errorf(`container can not be closed: %v`, err)
+		}
+	})
+
+	return container
+}
+
+// Override replaces the Binding inside the Container with the one from the BindingSource,
+// like genjector.Replace method does. At the end of the test, the previous Binding is
+// restored, or removed in case the Container did not have it.
+//
+// Binding instances defined in the parent Container resolve their dependencies from
+// the parent Container, so Override inside the child Container does not reach them.
+// For example, a constructor defined with genjector.AsConstructor1 method inside the
+// parent Container still receives the dependency from the parent Container. In that
+// case, the dependent Binding should be overridden inside the child Container as well,
+// or Override should be used directly with the parent Container.
+//
+// Example:
+// genjectortest.Override[RepositoryInterface](t, container, genjector.AsPointer[RepositoryInterface, *FakeRepository]())
+func Override[T any](t testing.TB, container *genjector.Container, source genjector.BindingSource[T], options ...genjector.BindingOption) {
+	t.Helper()
+
+	target := newFixedOption(source.Key(), container, options)
+	previous := lookup[T](target)
+
+	replaced, err := genjector.Replace[T](source, append([]genjector.BindingOption{genjector.WithContainer(container)}, options...)...)
+	if err != nil {
+		t.Fatalf(`binding for key "%s" can not be overridden: %v`, target.key, err)
+	}
+
+	restore[T](t, target, previous, replaced)
+}
+
+// Counter holds the number of times the Binding for the Key was resolved.
+//
+// It is safe for concurrent usage.
+type Counter struct {
+	key   genjector.Key
+	count atomic.Int64
+}
+
+// Count delivers the number of times the Binding was resolved.
+func (c *Counter) Count() int {
+	return int(c.count.Load())
+}
+
+// AssertResolved checks that the Binding was resolved exactly the desired number of times.
+//
+// Example:
+// counter.AssertResolved(t, 1)
+func (c *Counter) AssertResolved(t testing.TB, times int) {
+	t.Helper()
+
+	if count := c.Count(); count != times {
+		t.Errorf(`expected key "%s" to be resolved %d times, got: %d`, c.key, times, count)
+	}
+}
+
+// Spy wraps the Binding inside the Container, to count every time its instance is
+// requested from NewInstance method. The Binding must be already defined inside the
+// Container, or inside its parent Container. At the end of the test, the previous
+// Binding is restored.
+//
+// Example:
+// counter := genjectortest.Spy[RepositoryInterface](t, container)
+// ...
+// counter.AssertResolved(t, 1)
+func Spy[T any](t testing.TB, container *genjector.Container, options ...genjector.KeyOption) *Counter {
+	t.Helper()
+
+	bindingOptions := make([]genjector.BindingOption, 0, len(options))
+	for _, option := range options {
+		bindingOptions = append(bindingOptions, &keyOption{
+			KeyOption: option,
+		})
+	}
+
+	target := newFixedOption(genjector.Key{Value: (*T)(nil)}, container, bindingOptions)
+	previous := lookup[T](target)
+	if previous == nil {
+		t.Fatalf(`binding for key "%s" is not defined`, target.key)
+	}
+
+	counter := &Counter{
+		key: target.key,
+	}
+
+	replaced, err := genjector.Replace[T](&bindingSource[T]{
+		key: target.key,
+		binding: &countingBinding{
+			parent:  previous,
+			counter: counter,
+		},
+	}, target)
+	if err != nil {
+		t.Fatalf(`binding for key "%s" can not be spied: %v`, target.key, err)
+	}
+
+	restore[T](t, target, previous, replaced)
+	return counter
+}
+
+// AssertBound checks that the Binding is defined inside the Container,
+// or inside its parent Container.
+//
+// Example:
+// genjectortest.AssertBound[RepositoryInterface](t, container, genjector.WithAnnotation("primary"))
+func AssertBound[T any](t testing.TB, container *genjector.Container, options ...genjector.KeyOption) {
+	t.Helper()
+
+	if !genjector.IsBound[T](append([]genjector.KeyOption{genjector.WithContainer(container)}, options...)...) {
+		t.Errorf(`expected binding for key "%s" to be defined`, genjector.Key{Value: (*T)(nil)})
+	}
+}
+
+// AssertNotBound checks that the Binding is not defined inside the Container,
+// nor inside its parent Container.
+//
+// Example:
+// genjectortest.AssertNotBound[RepositoryInterface](t, container)
+func AssertNotBound[T any](t testing.TB, container *genjector.Container, options ...genjector.KeyOption) {
+	t.Helper()
+
+	if genjector.IsBound[T](append([]genjector.KeyOption{genjector.WithContainer(container)}, options...)...) {
+		t.Errorf(`expected binding for key "%s" not to be defined`, genjector.Key{Value: (*T)(nil)})
+	}
+}
+
+// lookup delivers the Binding currently used for the Key inside the Container,
+// or inside its parent Container, or nil in case it is not defined.
+func lookup[T any](target *fixedOption) genjector.Binding {
+	binding, _ := genjector.LookupBinding[T](target)
+	return binding
+}
+
+// restore brings back the previous Binding at the end of the test, or removes
+// the Binding in case the Container did not have it before.
+func restore[T any](t testing.TB, target *fixedOption, previous genjector.Binding, replaced bool) {
+	t.Helper()
+
+	t.Cleanup(func() {
+		if !replaced {
+			genjector.Unbind[T](target)
+			return
+		}
+
+		_, err := genjector.Replace[T](&bindingSource[T]{
+			key:     target.key,
+			binding: previous,
+		}, target)
+		if err != nil {
+			t.Errorf(`binding for key "%s" can not be restored: %v`, target.key, err)
+		}
+	})
+}
+
+// bindingSource is a concrete implementation for BindingSource interface.
+type bindingSource[T any] struct {
+	key     genjector.Key
+	binding genjector.Binding
+}
+
+// Key returns the Key of the Binding.
+//
+// It respects BindingSource interface.
+func (s *bindingSource[T]) Key() genjector.Key {
+	return s.key
+}
+
+// Binding returns already existing Binding.
+//
+// It respects BindingSource interface.
+func (s *bindingSource[T]) Binding() (genjector.Binding, error) {
+	return s.binding, nil
+}
+
+// countingBinding is a concrete implementation for Binding interface.
+type countingBinding struct {
+	parent  genjector.Binding
+	counter *Counter
+}
+
+// Instance increases the Counter and executes the same method from
+// the child Binding.
+//
+// It respects Binding interface.
+func (b *countingBinding) Instance(ctx context.Context, initialize bool) (interface{}, error) {
+	if initialize {
+		b.counter.count.Add(1)
+	}
+	return b.parent.Instance(ctx, initialize)
+}
+
+// fixedOption is a concrete implementation for BindingOption interface.
+type fixedOption struct {
+	key       genjector.Key
+	container *genjector.Container
+}
+
+// newFixedOption delivers a fixedOption with the final Key and Container,
+// made by applying all options to the Key and the Container.
+func newFixedOption(key genjector.Key, container *genjector.Container, options []genjector.BindingOption) *fixedOption {
+	for _, option := range options {
+		key = option.Key(key)
+		container = option.Container(container)
+	}
+
+	return &fixedOption{
+		key:       key,
+		container: container,
+	}
+}
+
+// Key returns the final Key.
+//
+// It respects BindingOption interface.
+func (o *fixedOption) Key(genjector.Key) genjector.Key {
+	return o.key
+}
+
+// Container returns the final Container.
+//
+// It respects BindingOption interface.
+func (o *fixedOption) Container(*genjector.Container) *genjector.Container {
+	return o.container
+}
+
+// Binding returns the same instance of Binding provided as an argument.
+//
+// It respects BindingOption interface.
+func (*fixedOption) Binding(binding genjector.Binding) (genjector.Binding, error) {
+	return binding, nil
+}
+
+// keyOption is a concrete implementation for BindingOption interface.
+type keyOption struct {
+	genjector.KeyOption
+}
+
+// Binding returns the same instance of Binding provided as an argument.
+//
+// It respects BindingOption interface.
+func (*keyOption) Binding(binding genjector.Binding) (genjector.Binding, error) {
+	return binding, nil
+}
@@ -0,0 +1,217 @@
+package genjectortest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ompluscator/genjector"
+)
+
+type testCloser struct {
+	closed bool
+}
+
+func (c *testCloser) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestNewContainer(t *testing.T) {
+	t.Parallel()
+
+	var closer *testCloser
+	t.Run("container", func(t *testing.T) {
+		container := NewContainer(t)
+		genjector.MustBind[*testCloser](genjector.AsPointer[*testCloser, *testCloser](), genjector.AsSingleton(), genjector.WithContainer(container))
+
+		var err error
+		closer, err = genjector.NewInstance[*testCloser](genjector.WithContainer(container))
+		if err != nil {
+			t.Fatalf(`expected nil, got: %v`, err)
+		}
+	})
+
+	if !closer.closed {
+		t.Error("expected closed instance")
+	}
+}
+
+func TestNewChildContainer(t *testing.T) {
+	t.Parallel()
+
+	parent := genjector.NewContainer()
+	genjector.MustBind[int](genjector.AsInstance[int](1), genjector.WithContainer(parent))
+
+	t.Run("child", func(t *testing.T) {
+		child := NewChildContainer(t, parent)
+		Override[int](t, child, genjector.AsInstance[int](2))
+
+		instance, err := genjector.NewInstance[int](genjector.WithContainer(child))
+		if err != nil {
+			t.Fatalf(`expected nil, got: %v`, err)
+		}
+		if instance != 2 {
+			t.Errorf(`expected 2, got: %d`, instance)
+		}
+	})
+
+	t.Run("parent dependency", func(t *testing.T) {
+		genjector.MustBind[string](genjector.AsConstructor1[string](func(value int) (string, error) {
+			return fmt.Sprint(value), nil
+		}), genjector.WithContainer(parent), genjector.WithAnnotation("dependent"))
+
+		child := NewChildContainer(t, parent)
+		Override[int](t, child, genjector.AsInstance[int](2))
+
+		value, err := genjector.NewInstance[string](genjector.WithContainer(child), genjector.WithAnnotation("dependent"))
+		if err != nil {
+			t.Fatalf(`expected nil, got: %v`, err)
+		}
+		if value != "1" {
+			t.Errorf(`expected 1, got: %s`, value)
+		}
+
+		Override[string](t, child, genjector.AsConstructor1[string](func(value int) (string, error) {
+			return fmt.Sprint(value), nil
+		}), genjector.WithAnnotation("dependent"))
+
+		value, err = genjector.NewInstance[string](genjector.WithContainer(child), genjector.WithAnnotation("dependent"))
+		if err != nil {
+			t.Fatalf(`expected nil, got: %v`, err)
+		}
+		if value != "2" {
+			t.Errorf(`expected 2, got: %s`, value)
+		}
+	})
+
+	instance, err := genjector.NewInstance[int](genjector.WithContainer(parent))
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+	if instance != 1 {
+		t.Errorf(`expected 1, got: %d`, instance)
+	}
+}
+
+func TestOverride(t *testing.T) {
+	t.Parallel()
+
+	container := genjector.NewContainer()
+	genjector.MustBind[string](genjector.AsInstance[string]("value"), genjector.WithContainer(container), genjector.WithAnnotation("annotation"))
+	genjector.MustBind[int](genjector.InSlice[int](genjector.AsInstance[int](1)), genjector.WithContainer(container))
+
+	t.Run("override", func(t *testing.T) {
+		Override[string](t, container, genjector.AsInstance[string]("fake"), genjector.WithAnnotation("annotation"))
+		Override[int](t, container, genjector.InSlice[int](genjector.AsInstance[int](2)))
+		Override[bool](t, container, genjector.AsInstance[bool](true))
+
+		value, err := genjector.NewInstance[string](genjector.WithContainer(container), genjector.WithAnnotation("annotation"))
+		if err != nil {
+			t.Fatalf(`expected nil, got: %v`, err)
+		}
+		if value != "fake" {
+			t.Errorf(`expected fake, got: %s`, value)
+		}
+
+		values, err := genjector.NewInstance[[]int](genjector.WithContainer(container))
+		if err != nil {
+			t.Fatalf(`expected nil, got: %v`, err)
+		}
+		if len(values) != 1 || values[0] != 2 {
+			t.Errorf(`expected [2], got: %v`, values)
+		}
+
+		AssertBound[bool](t, container)
+	})
+
+	value, err := genjector.NewInstance[string](genjector.WithContainer(container), genjector.WithAnnotation("annotation"))
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+	if value != "value" {
+		t.Errorf(`expected value, got: %s`, value)
+	}
+
+	values, err := genjector.NewInstance[[]int](genjector.WithContainer(container))
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+	if len(values) != 1 || values[0] != 1 {
+		t.Errorf(`expected [1], got: %v`, values)
+	}
+
+	AssertNotBound[bool](t, container)
+}
+
+func TestSpy(t *testing.T) {
+	t.Parallel()
+
+	container := NewContainer(t)
+	genjector.MustBind[int](genjector.AsProvider[int](func() (int, error) {
+		return 1, nil
+	}), genjector.AsSingleton(), genjector.WithContainer(container), genjector.WithAnnotation("annotation"))
+	genjector.MustBind[string](genjector.AsConstructor1[string](func(value int) (string, error) {
+		return "value", nil
+	}), genjector.WithContainer(container))
+	genjector.MustBind[int](genjector.AsContextProvider[int](func(ctx context.Context) (int, error) {
+		return genjector.NewInstanceContext[int](ctx, genjector.WithAnnotation("annotation"))
+	}), genjector.WithContainer(container))
+
+	t.Run("spy", func(t *testing.T) {
+		counter := Spy[int](t, container, genjector.WithAnnotation("annotation"))
+
+		for i := 0; i < 3; i++ {
+			_, err := genjector.NewInstance[string](genjector.WithContainer(container))
+			if err != nil {
+				t.Fatalf(`expected nil, got: %v`, err)
+			}
+		}
+
+		counter.AssertResolved(t, 3)
+	})
+
+	instance, err := genjector.NewInstance[int](genjector.WithContainer(container), genjector.WithAnnotation("annotation"))
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+	if instance != 1 {
+		t.Errorf(`expected 1, got: %d`, instance)
+	}
+}
+
+func TestCounter_AssertResolved(t *testing.T) {
+	t.Parallel()
+
+	counter := &Counter{
+		key: genjector.Key{Value: (*int)(nil)},
+	}
+	counter.count.Add(2)
+
+	inner := &testing.T{}
+	counter.AssertResolved(inner, 1)
+	if !inner.Failed() {
+		t.Error("expected failed assertion")
+	}
+}
+
+func Test_lookup(t *testing.T) {
+	t.Parallel()
+
+	parent := genjector.NewContainer()
+	container := genjector.NewChildContainer(parent)
+	if previous := lookup[int](newFixedOption(genjector.Key{Value: (*int)(nil)}, container, nil)); previous != nil {
+		t.Errorf(`expected nil, got: %v`, previous)
+	}
+
+	genjector.MustBind[int](genjector.AsInstance[int](1), genjector.WithContainer(parent))
+	previous := lookup[int](newFixedOption(genjector.Key{Value: (*int)(nil)}, container, nil))
+	if previous == nil {
+		t.Fatal("expected binding, got nil")
+	}
+
+	instance, err := previous.Instance(context.Background(), true)
+	if err != nil || instance != 1 {
+		t.Errorf(`expected 1, got: %v, %v`, instance, err)
+	}
+}
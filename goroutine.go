@@ -0,0 +1,26 @@
+package genjector
+
+import (
+	"bytes"
+	"runtime"
+	"strconv"
+)
+
+// goroutineID delivers the identifier of the current goroutine, by reading
+// it from the first line of its stack trace, like "goroutine 18 [running]:".
+//
+// It is expensive, so it is executed only while resolutions are traced:
+// during the first construction of a singleton, or when the cycle without
+// the context.Context is suspected.
+func goroutineID() uint64 {
+	var buffer [64]byte
+	line := buffer[:runtime.Stack(buffer[:], false)]
+
+	line = bytes.TrimPrefix(line, []byte("goroutine "))
+	if index := bytes.IndexByte(line, ' '); index >= 0 {
+		line = line[:index]
+	}
+
+	id, _ := strconv.ParseUint(string(line), 10, 64)
+	return id
+}
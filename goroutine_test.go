@@ -0,0 +1,18 @@
+package genjector
+
+import "testing"
+
+func Test_goroutineID(t *testing.T) {
+	current := goroutineID()
+	if current == 0 || current != goroutineID() {
+		t.Errorf(`expected the same identifier, got: %d`, current)
+	}
+
+	other := make(chan uint64)
+	go func() {
+		other <- goroutineID()
+	}()
+	if id := <-other; id == 0 || id == current {
+		t.Errorf(`expected different identifier, got: %d`, id)
+	}
+}
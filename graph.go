@@ -0,0 +1,375 @@
+package genjector
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// GraphEdgeDependency is a kind of GraphEdge between a Binding and its dependency.
+const GraphEdgeDependency = "dependency"
+
+// GraphEdgeMember is a kind of GraphEdge between a slice (or a map) and its member.
+const GraphEdgeMember = "member"
+
+// GraphEdgeAlternative is a kind of GraphEdge between a Key and one of its Binding
+// instances, defined with WithCondition or WithProfile methods.
+const GraphEdgeAlternative = "alternative"
+
+// GraphNode represents a single Key inside the Graph, a single member
+// of a slice (or a map) defined with InSlice (or InMap) method, or a single
+// alternative defined with WithCondition (or WithProfile) method. Keys and
+// alternatives that do not take effect inside the Container are marked as inactive.
+type GraphNode struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Annotation string `json:"annotation,omitempty"`
+	Bound      bool   `json:"bound"`
+	Inactive   bool   `json:"inactive,omitempty"`
+}
+
+// GraphEdge represents a relation between two instances of GraphNode.
+type GraphEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Kind string `json:"kind"`
+}
+
+// Graph represents dependencies between all Binding instances that can be
+// used with a Container. Nodes and edges are always sorted, so the same
+// Container setup always delivers the same Graph.
+type Graph struct {
+	Nodes []GraphNode `json:"nodes"`
+	Edges []GraphEdge `json:"edges"`
+}
+
+// DOT delivers the Graph in Graphviz DOT format.
+func (g *Graph) DOT() string {
+	var builder strings.Builder
+	builder.WriteString("digraph genjector {\n")
+
+	for _, node := range g.Nodes {
+		style := ""
+		if !node.Bound {
+			style = ", style=dashed"
+		} else if node.Inactive {
+			style = ", style=dotted"
+		}
+		fmt.Fprintf(&builder, "  %s [label=%s%s];\n", strconv.Quote(node.ID), strconv.Quote(node.ID), style)
+	}
+
+	for _, edge := range g.Edges {
+		style := ""
+		if edge.Kind != GraphEdgeDependency {
+			style = " [style=dashed]"
+		}
+		fmt.Fprintf(&builder, "  %s -> %s%s;\n", strconv.Quote(edge.From), strconv.Quote(edge.To), style)
+	}
+
+	builder.WriteString("}\n")
+	return builder.String()
+}
+
+// Mermaid delivers the Graph in Mermaid flowchart format.
+func (g *Graph) Mermaid() string {
+	var builder strings.Builder
+	builder.WriteString("flowchart LR\n")
+
+	inactive := false
+	identifiers := make(map[string]string, len(g.Nodes))
+	for i, node := range g.Nodes {
+		identifier := fmt.Sprintf("n%d", i)
+		identifiers[node.ID] = identifier
+
+		class := ""
+		if node.Bound && node.Inactive {
+			class = ":::inactive"
+			inactive = true
+		}
+
+		label := strings.ReplaceAll(node.ID, `"`, "#quot;")
+		if node.Bound {
+			fmt.Fprintf(&builder, "  %s[\"%s\"]%s\n", identifier, label, class)
+		} else {
+			fmt.Fprintf(&builder, "  %s([\"%s\"])\n", identifier, label)
+		}
+	}
+
+	for _, edge := range g.Edges {
+		arrow := "-->"
+		if edge.Kind != GraphEdgeDependency {
+			arrow = "-.->"
+		}
+		fmt.Fprintf(&builder, "  %s %s %s\n", identifiers[edge.From], arrow, identifiers[edge.To])
+	}
+
+	if inactive {
+		builder.WriteString("  classDef inactive stroke-dasharray: 2 2\n")
+	}
+
+	return builder.String()
+}
+
+// JSON delivers the Graph in JSON format.
+func (g *Graph) JSON() ([]byte, error) {
+	return json.MarshalIndent(g, "", "  ")
+}
+
+// recorder is a struct used for storing all dependencies between keys,
+// that are discovered during execution of NewInstance method.
+//
+// It is safe for concurrent usage.
+type recorder struct {
+	mutex sync.RWMutex
+	edges map[[2]Key]struct{}
+}
+
+// record stores the dependency between two keys, if it is not already stored.
+func (r *recorder) record(from Key, to Key) {
+	edge := [2]Key{from, to}
+
+	r.mutex.RLock()
+	_, ok := r.edges[edge]
+	r.mutex.RUnlock()
+	if ok {
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.edges == nil {
+		r.edges = map[[2]Key]struct{}{}
+	}
+	r.edges[edge] = struct{}{}
+}
+
+// recorded delivers all stored dependencies.
+func (r *recorder) recorded() [][2]Key {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	result := make([][2]Key, 0, len(r.edges))
+	for edge := range r.edges {
+		result = append(result, edge)
+	}
+	return result
+}
+
+// reset forgets all stored dependencies.
+func (r *recorder) reset() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.edges = nil
+}
+
+// wrappingBinding represents a Binding that wraps another Binding,
+// like singletonBinding does.
+type wrappingBinding interface {
+	unwrap() Binding
+}
+
+// dependentBinding represents a Binding that declares its dependencies
+// before its instance is created, like constructorBinding does.
+type dependentBinding interface {
+	dependencies() []Key
+}
+
+// groupBinding represents a Binding that holds multiple Binding
+// instances, like sliceBinding and mapBinding do.
+type groupBinding interface {
+	members(container *Container) []graphMember
+}
+
+// graphMember represents a single Binding inside groupBinding.
+type graphMember struct {
+	name    string
+	element Key
+	binding Binding
+}
+
+// graphBuilder is a struct used for making the Graph for the Container.
+type graphBuilder struct {
+	container *Container
+	nodes     map[string]GraphNode
+	edges     map[GraphEdge]struct{}
+}
+
+// key adds GraphNode for the Key and delivers its identifier.
+func (b *graphBuilder) key(key Key) string {
+	identifier := key.String()
+	if _, ok := b.nodes[identifier]; !ok {
+		binding, _, bound := b.container.binding(key.Generate())
+
+		inactive := false
+		if bound {
+			_, active := b.container.active(binding)
+			inactive = !active
+		}
+
+		b.nodes[identifier] = GraphNode{
+			ID:         identifier,
+			Type:       Key{Value: key.Value}.String(),
+			Annotation: key.Annotation,
+			Bound:      bound,
+			Inactive:   inactive,
+		}
+	}
+	return identifier
+}
+
+// binding adds all dependencies and members of the Binding to the Graph.
+func (b *graphBuilder) binding(identifier string, binding Binding) {
+	for {
+		if conditional, ok := binding.(*conditionalBinding); ok {
+			b.alternatives(identifier, conditional)
+			return
+		}
+
+		wrapping, ok := binding.(wrappingBinding)
+		if !ok {
+			break
+		}
+		binding = wrapping.unwrap()
+	}
+
+	if dependent, ok := binding.(dependentBinding); ok {
+		for _, dependency := range dependent.dependencies() {
+			b.edge(identifier, b.key(dependency), GraphEdgeDependency)
+		}
+	}
+
+	if group, ok := binding.(groupBinding); ok {
+		for _, member := range group.members(b.container) {
+			memberIdentifier := fmt.Sprintf("%s[%s]", identifier, member.name)
+			b.nodes[memberIdentifier] = GraphNode{
+				ID:    memberIdentifier,
+				Type:  member.element.String(),
+				Bound: true,
+			}
+			b.edge(identifier, memberIdentifier, GraphEdgeMember)
+			b.binding(memberIdentifier, member.binding)
+		}
+	}
+}
+
+// alternatives adds all Binding instances chained inside the conditionalBinding
+// to the Graph, by marking all of them, except the selected one, as inactive.
+func (b *graphBuilder) alternatives(identifier string, conditional *conditionalBinding) {
+	node := b.nodes[identifier]
+
+	selected := false
+	var binding Binding = conditional
+	for binding != nil {
+		current, name, active := binding, "default", true
+		binding = nil
+
+		if conditional, ok := current.(*conditionalBinding); ok {
+			current, name, active = conditional.current, conditional.String(), conditional.matches(b.container)
+			binding = conditional.previous
+		}
+
+		alternativeIdentifier := fmt.Sprintf("%s{%s}", identifier, name)
+		for i := 1; ; i++ {
+			if _, ok := b.nodes[alternativeIdentifier]; !ok {
+				break
+			}
+			alternativeIdentifier = fmt.Sprintf("%s{%s#%d}", identifier, name, i)
+		}
+
+		b.nodes[alternativeIdentifier] = GraphNode{
+			ID:         alternativeIdentifier,
+			Type:       node.Type,
+			Annotation: node.Annotation,
+			Bound:      true,
+			Inactive:   selected || !active,
+		}
+		selected = selected || active
+
+		b.edge(identifier, alternativeIdentifier, GraphEdgeAlternative)
+		b.binding(alternativeIdentifier, current)
+	}
+}
+
+// edge adds GraphEdge to the Graph.
+func (b *graphBuilder) edge(from string, to string, kind string) {
+	b.edges[GraphEdge{
+		From: from,
+		To:   to,
+		Kind: kind,
+	}] = struct{}{}
+}
+
+// graph delivers the sorted Graph.
+func (b *graphBuilder) graph() *Graph {
+	graph := &Graph{
+		Nodes: make([]GraphNode, 0, len(b.nodes)),
+		Edges: make([]GraphEdge, 0, len(b.edges)),
+	}
+
+	for _, node := range b.nodes {
+		graph.Nodes = append(graph.Nodes, node)
+	}
+	sort.Slice(graph.Nodes, func(i, j int) bool {
+		return graph.Nodes[i].ID < graph.Nodes[j].ID
+	})
+
+	for edge := range b.edges {
+		graph.Edges = append(graph.Edges, edge)
+	}
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		if graph.Edges[i].From != graph.Edges[j].From {
+			return graph.Edges[i].From < graph.Edges[j].From
+		}
+		if graph.Edges[i].To != graph.Edges[j].To {
+			return graph.Edges[i].To < graph.Edges[j].To
+		}
+		return graph.Edges[i].Kind < graph.Edges[j].Kind
+	})
+
+	return graph
+}
+
+// DependencyGraph delivers the Graph of all Binding instances that can be used
+// with the Container, including the ones from parent Container. Dependencies are
+// taken from constructor methods defined with AsConstructor methods, as well as
+// from all executions of NewInstanceContext method inside other Binding instances.
+// Keys that are used as dependencies, but are not bound, are marked as not bound.
+//
+// Example:
+// graph := customContainer.DependencyGraph()
+// fmt.Println(graph.DOT())
+func (c *Container) DependencyGraph() *Graph {
+	builder := &graphBuilder{
+		container: c,
+		nodes:     map[string]GraphNode{},
+		edges:     map[GraphEdge]struct{}{},
+	}
+
+	for _, key := range c.keys() {
+		binding, _, _ := c.binding(key.Generate())
+		builder.binding(builder.key(key), binding)
+	}
+
+	for container := c; container != nil; container = container.parent {
+		for _, edge := range container.recorder.recorded() {
+			builder.edge(builder.key(edge[0]), builder.key(edge[1]), GraphEdgeDependency)
+		}
+	}
+
+	return builder.graph()
+}
+
+// DependencyGraph delivers the Graph of all Binding instances from the standard
+// internal (global) Container, in the same way as DependencyGraph method of the Container.
+//
+// Example:
+// graph := genjector.DependencyGraph()
+// fmt.Println(graph.Mermaid())
+func DependencyGraph() *Graph {
+	return global.DependencyGraph()
+}
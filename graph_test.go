@@ -0,0 +1,163 @@
+package genjector
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestRecorder(t *testing.T) {
+	var r recorder
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r.record(Key{Value: (*int)(nil)}, Key{Value: (*string)(nil)})
+		}()
+	}
+	wg.Wait()
+
+	edges := r.recorded()
+	if !reflect.DeepEqual(edges, [][2]Key{{{Value: (*int)(nil)}, {Value: (*string)(nil)}}}) {
+		t.Errorf(`expected single edge, got: %v`, edges)
+	}
+}
+
+func TestContainer_DependencyGraph(t *testing.T) {
+	parent := NewContainer()
+	MustBind[int](AsInstance[int](10), WithContainer(parent))
+	MustBind[string](AsInstance[string]("first"), WithContainer(parent), WithAnnotation("first"))
+
+	child := NewChildContainer(parent)
+	MustBind[*testStruct](AsConstructor2[*testStruct](func(a int, b bool) (*testStruct, error) {
+		return &testStruct{}, nil
+	}), AsSingleton(), WithContainer(child))
+	MustBind[string](InSlice[string](AsInstance[string]("value")), WithContainer(child))
+	MustBind[string](InSlice[string](AsContextProvider[string](func(ctx context.Context) (string, error) {
+		return NewInstanceContext[string](ctx, WithAnnotation("first"))
+	})), WithContainer(child))
+	MustBind[string](InMap[string, string]("key", AsConstructor1[string](func(a int) (string, error) {
+		return "value", nil
+	})), WithContainer(child))
+
+	_, err := NewInstance[[]string](WithContainer(child))
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+
+	graph := child.DependencyGraph()
+
+	expectedNodes := []GraphNode{
+		{ID: "*github.com/ompluscator/genjector.testStruct", Type: "*github.com/ompluscator/genjector.testStruct", Bound: true},
+		{ID: "[]string", Type: "[]string", Bound: true},
+		{ID: "[]string[0]", Type: "string", Bound: true},
+		{ID: "[]string[1]", Type: "string", Bound: true},
+		{ID: "bool", Type: "bool", Bound: false},
+		{ID: "int", Type: "int", Bound: true},
+		{ID: "map[string]string", Type: "map[string]string", Bound: true},
+		{ID: "map[string]string[key]", Type: "string", Bound: true},
+		{ID: "string@first", Type: "string", Annotation: "first", Bound: true},
+	}
+	if !reflect.DeepEqual(graph.Nodes, expectedNodes) {
+		t.Errorf(`expected nodes %v, got: %v`, expectedNodes, graph.Nodes)
+	}
+
+	expectedEdges := []GraphEdge{
+		{From: "*github.com/ompluscator/genjector.testStruct", To: "bool", Kind: GraphEdgeDependency},
+		{From: "*github.com/ompluscator/genjector.testStruct", To: "int", Kind: GraphEdgeDependency},
+		{From: "[]string", To: "[]string[0]", Kind: GraphEdgeMember},
+		{From: "[]string", To: "[]string[1]", Kind: GraphEdgeMember},
+		{From: "[]string", To: "string@first", Kind: GraphEdgeDependency},
+		{From: "map[string]string", To: "map[string]string[key]", Kind: GraphEdgeMember},
+		{From: "map[string]string[key]", To: "int", Kind: GraphEdgeDependency},
+	}
+	if !reflect.DeepEqual(graph.Edges, expectedEdges) {
+		t.Errorf(`expected edges %v, got: %v`, expectedEdges, graph.Edges)
+	}
+}
+
+func TestGraph_DOT(t *testing.T) {
+	graph := &Graph{
+		Nodes: []GraphNode{
+			{ID: "[]int", Type: "[]int", Bound: true},
+			{ID: "[]int[0]", Type: "int", Bound: true},
+			{ID: "string", Type: "string", Bound: false},
+		},
+		Edges: []GraphEdge{
+			{From: "[]int", To: "[]int[0]", Kind: GraphEdgeMember},
+			{From: "[]int[0]", To: "string", Kind: GraphEdgeDependency},
+		},
+	}
+
+	expected := strings.Join([]string{
+		`digraph genjector {`,
+		`  "[]int" [label="[]int"];`,
+		`  "[]int[0]" [label="[]int[0]"];`,
+		`  "string" [label="string", style=dashed];`,
+		`  "[]int" -> "[]int[0]" [style=dashed];`,
+		`  "[]int[0]" -> "string";`,
+		`}`,
+		``,
+	}, "\n")
+	if result := graph.DOT(); result != expected {
+		t.Errorf(`expected %q, got: %q`, expected, result)
+	}
+}
+
+func TestGraph_Mermaid(t *testing.T) {
+	graph := &Graph{
+		Nodes: []GraphNode{
+			{ID: "[]int", Type: "[]int", Bound: true},
+			{ID: `map[string]int["key"]`, Type: "int", Bound: true},
+			{ID: "string", Type: "string", Bound: false},
+		},
+		Edges: []GraphEdge{
+			{From: "[]int", To: `map[string]int["key"]`, Kind: GraphEdgeMember},
+			{From: `map[string]int["key"]`, To: "string", Kind: GraphEdgeDependency},
+		},
+	}
+
+	expected := strings.Join([]string{
+		`flowchart LR`,
+		`  n0["[]int"]`,
+		`  n1["map[string]int[#quot;key#quot;]"]`,
+		`  n2(["string"])`,
+		`  n0 -.-> n1`,
+		`  n1 --> n2`,
+		``,
+	}, "\n")
+	if result := graph.Mermaid(); result != expected {
+		t.Errorf(`expected %q, got: %q`, expected, result)
+	}
+}
+
+func TestGraph_JSON(t *testing.T) {
+	graph := &Graph{
+		Nodes: []GraphNode{
+			{ID: "int@annotation", Type: "int", Annotation: "annotation", Bound: true},
+		},
+		Edges: []GraphEdge{},
+	}
+
+	data, err := graph.JSON()
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+
+	var result Graph
+	err = json.Unmarshal(data, &result)
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+	if !reflect.DeepEqual(&result, graph) {
+		t.Errorf(`expected %v, got: %v`, graph, result)
+	}
+	if !strings.Contains(string(data), `"annotation": "annotation"`) {
+		t.Errorf(`expected annotation in JSON, got: %s`, data)
+	}
+}
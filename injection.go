@@ -1,7 +1,11 @@
 package genjector
 
 import (
+	"context"
 	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
 )
 
 // Key is a struct that contains information for Binding keys
@@ -21,6 +25,83 @@ func (k Key) Generate() interface{} {
 	return k.Value
 }
 
+// String delivers a readable name of the Key, made from the full name of
+// the type (including the package path) and the annotation.
+//
+// Example:
+// github.com/acme/repo.UserRepo@primary
+// []github.com/acme/repo.Handler
+// map[string]github.com/acme/repo.Handler
+//
+// It respects fmt.Stringer interface.
+func (k Key) String() string {
+	var name string
+	switch value := reflect.TypeOf(k.Value); {
+	case value == nil:
+		name = "<nil>"
+	case value.Kind() == reflect.Pointer:
+		name = typeName(value.Elem())
+	default:
+		name = typeName(value)
+	}
+
+	if len(k.Annotation) > 0 {
+		name += "@" + k.Annotation
+	}
+	return name
+}
+
+// typeName delivers the full name of the type, where all named
+// types contain their package path.
+func typeName(value reflect.Type) string {
+	if value == nil {
+		return "<nil>"
+	}
+
+	if len(value.Name()) > 0 {
+		if len(value.PkgPath()) > 0 {
+			return value.PkgPath() + "." + value.Name()
+		}
+		return value.Name()
+	}
+
+	switch value.Kind() {
+	case reflect.Pointer:
+		return "*" + typeName(value.Elem())
+	case reflect.Slice:
+		return "[]" + typeName(value.Elem())
+	case reflect.Array:
+		return fmt.Sprintf("[%d]%s", value.Len(), typeName(value.Elem()))
+	case reflect.Map:
+		return fmt.Sprintf("map[%s]%s", typeName(value.Key()), typeName(value.Elem()))
+	case reflect.Chan:
+		switch value.ChanDir() {
+		case reflect.RecvDir:
+			return "<-chan " + typeName(value.Elem())
+		case reflect.SendDir:
+			return "chan<- " + typeName(value.Elem())
+		}
+		return "chan " + typeName(value.Elem())
+	}
+
+	return value.String()
+}
+
+// keyFromGenerated delivers the Key from which the generated key is made.
+func keyFromGenerated(generated interface{}) Key {
+	if value, ok := generated.([2]interface{}); ok {
+		if annotation, ok := value[0].(string); ok {
+			return Key{
+				Annotation: annotation,
+				Value:      value[1],
+			}
+		}
+	}
+	return Key{
+		Value: generated,
+	}
+}
+
 // KeySource represents an interface that builds a Key for Binding.
 type KeySource interface {
 	Key() Key
@@ -30,13 +111,17 @@ type KeySource interface {
 // and Container.
 type KeyOption interface {
 	Key(key Key) Key
-	Container(container Container) Container
+	Container(container *Container) *Container
 }
 
 // Binding represents an interface that delivers new instance for
 // particular interface (or a struct).
+//
+// The context.Context holds the state of the resolution, like the Container,
+// the Scope and the chain of keys used for detecting cycles. Binding instances
+// that execute other ones should pass the same context.Context to them.
 type Binding interface {
-	Instance(initialize bool) (interface{}, error)
+	Instance(ctx context.Context, initialize bool) (interface{}, error)
 }
 
 // BindingSource represents an interface that delivers starting Key and
@@ -58,19 +143,239 @@ type FollowingBindingSource[T any] interface {
 // Binding and Container.
 type BindingOption interface {
 	Key(key Key) Key
-	Container(container Container) Container
+	Container(container *Container) *Container
 	Binding(binding Binding) (Binding, error)
 }
 
-// Container is a child type used for storing all Binding instances.
-type Container map[interface{}]Binding
+// resolution holds the state of a single execution of NewInstance method,
+// which is available to all Binding instances through the context.Context.
+type resolution struct {
+	container  *Container
+	scope      *Scope
+	key        Key
+	strict     bool
+	validation *disposer
+	previous   *resolution
+}
+
+// cycle checks if the same Key from the same Container is already in
+// the process of initialization, in one of the preceding resolutions.
+// In that case, it delivers CycleError with the complete path.
+func (r *resolution) cycle() error {
+	for previous := r.previous; previous != nil; previous = previous.previous {
+		if previous.key != r.key || previous.container != r.container {
+			continue
+		}
+
+		path := []Key{r.key}
+		for current := r.previous; current != previous; current = current.previous {
+			path = append(path, current.key)
+		}
+		path = append(path, previous.key)
+
+		for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+			path[i], path[j] = path[j], path[i]
+		}
+
+		return &CycleError{
+			Path: path,
+		}
+	}
+
+	return nil
+}
+
+// resolutionKey is a key for storing resolution inside the context.Context.
+type resolutionKey struct{}
+
+// resolutionContext is a context.Context that holds the resolution, so
+// both of them can be created with a single allocation.
+type resolutionContext struct {
+	context.Context
+	resolution resolution
+}
+
+// Value delivers the inner resolution for the resolutionKey, or
+// executes the same method from the parent context.Context.
+//
+// It respects context.Context interface.
+func (c *resolutionContext) Value(key interface{}) interface{} {
+	if key == (resolutionKey{}) {
+		return &c.resolution
+	}
+	return c.Context.Value(key)
+}
+
+// resolutionFromContext delivers the resolution stored inside the context.Context.
+// In case it is not present, it delivers an empty resolution.
+func resolutionFromContext(ctx context.Context) *resolution {
+	if value, ok := ctx.Value(resolutionKey{}).(*resolution); ok {
+		return value
+	}
+	return &resolution{}
+}
+
+// resolutionOption represents a KeyOption that additionally overrides
+// the state of the NewInstance method.
+type resolutionOption interface {
+	apply(resolution *resolution)
+}
+
+// Container is a struct used for storing all Binding instances.
+//
+// It is safe for concurrent usage. Reading is done without any locking,
+// from an immutable snapshot of all Binding instances, while every change
+// creates a new snapshot and atomically replaces the old one.
+//
+// Container can have a parent Container, which is used for all Binding
+// instances that are not defined in the Container itself.
+type Container struct {
+	parent       *Container
+	bindings     atomic.Pointer[map[interface{}]Binding]
+	mutex        sync.Mutex
+	disposer     disposer
+	recorder     recorder
+	interceptors interceptors
+	strict       atomic.Bool
+	installation installation
+	profiles     profiles
+	revision     uint64
+	revisions    map[interface{}]uint64
+}
 
 // global is a concrete global Container
 var global = NewContainer()
 
 // NewContainer delivers a new instance of Container.
-func NewContainer() Container {
-	return map[interface{}]Binding{}
+func NewContainer() *Container {
+	container := &Container{}
+	container.bindings.Store(&map[interface{}]Binding{})
+	return container
+}
+
+// NewChildContainer delivers a new instance of Container, that uses the
+// parent Container for all Binding instances it does not define itself.
+// Binding instances stored in the child Container shadow the ones from the
+// parent Container, while the parent Container stays unchanged. That way,
+// singletons defined in the parent Container are shared across all children.
+//
+// Example:
+// child := genjector.NewChildContainer(parent)
+//
+// err := genjector.Bind(
+//
+//	genjector.AsPointer[ContainerInterface, *ContainerStruct](),
+//	genjector.WithContainer(child),
+//
+// )
+func NewChildContainer(parent *Container) *Container {
+	container := NewContainer()
+	container.parent = parent
+	return container
+}
+
+// binding delivers the Binding stored under the generated key, by
+// reading the current snapshot of the Container. In case it is not present,
+// it is searched inside the parent Container. Together with the Binding,
+// it delivers the Container where the Binding is found.
+func (c *Container) binding(key interface{}) (Binding, *Container, bool) {
+	for container := c; container != nil; container = container.parent {
+		binding, ok := (*container.bindings.Load())[key]
+		if ok {
+			return binding, container, true
+		}
+	}
+	return nil, nil, false
+}
+
+// snapshot delivers the current, immutable map of all Binding instances
+// stored in the Container itself. Returned map must not be modified.
+func (c *Container) snapshot() map[interface{}]Binding {
+	return *c.bindings.Load()
+}
+
+// modify executes the function with a copy of the current snapshot and,
+// in case there is no error, stores that copy as a new snapshot. All calls
+// are serialized, so no changes can be lost between concurrent calls.
+func (c *Container) modify(modifier func(bindings map[interface{}]Binding) error) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	current := *c.bindings.Load()
+	bindings := make(map[interface{}]Binding, len(current)+1)
+	for key, binding := range current {
+		bindings[key] = binding
+	}
+
+	err := modifier(bindings)
+	if err != nil {
+		return err
+	}
+
+	c.bindings.Store(&bindings)
+	return nil
+}
+
+// entry delivers the Binding stored under the generated key inside the Container
+// itself, together with the revision of that key, which changes on every change
+// of the Binding stored under the same key.
+func (c *Container) entry(generated interface{}) (Binding, bool, uint64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	binding, ok := (*c.bindings.Load())[generated]
+	return binding, ok, c.revisions[generated]
+}
+
+// touch changes the revision of the generated key. It must be executed
+// while the Container is locked.
+func (c *Container) touch(generated interface{}) {
+	if c.revisions == nil {
+		c.revisions = map[interface{}]uint64{}
+	}
+	c.revision++
+	c.revisions[generated] = c.revision
+}
+
+// swap stores the Binding under the generated key in a new snapshot. In case
+// strict is set, the Binding is stored only if the revision of the key is still
+// the same, as the Binding is made from the Binding previously stored under that
+// key. It reports whether the Binding replaced the existing one, and whether
+// it is stored.
+func (c *Container) swap(generated interface{}, binding Binding, revision uint64, strict bool) (bool, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if strict && c.revisions[generated] != revision {
+		return false, false
+	}
+
+	current := *c.bindings.Load()
+	bindings := make(map[interface{}]Binding, len(current)+1)
+	for key, value := range current {
+		bindings[key] = value
+	}
+
+	_, replaced := bindings[generated]
+	bindings[generated] = binding
+	c.touch(generated)
+
+	c.bindings.Store(&bindings)
+	return replaced, true
+}
+
+// clean replaces the current snapshot with an empty one.
+func (c *Container) clean() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.bindings.Store(&map[interface{}]Binding{})
+	c.revisions = nil
+	c.recorder.reset()
+	c.interceptors.reset()
+	c.strict.Store(false)
+	c.installation.reset()
+	c.profiles.list.Store(nil)
 }
 
 // Bind executes complete logic for binding particular value (or pointer) to
@@ -84,7 +389,20 @@ func NewContainer() Container {
 // it uses its own fallback Binding. Still, it works fully only for values, not pointers,
 // as for pointers it returns nil value. That means that pointer Binding
 // should be always defined.
+//
+// Binding is made without locking the Container, so ProviderMethod executed by
+// AsProvider method can define other Binding instances. In case the Binding for
+// the same Key is changed in the meantime, the Binding is made again.
 func Bind[T any](source BindingSource[T], options ...BindingOption) error {
+	_, err := bind[T](source, true, options...)
+	return err
+}
+
+// bind executes complete logic of Bind and Replace methods. In case chain is
+// set, the preceding Binding is passed to FollowingBindingSource, so slices
+// and maps defined with InSlice and InMap methods can be extended. It reports
+// whether the Container already had the Binding for the same Key.
+func bind[T any](source BindingSource[T], chain bool, options ...BindingOption) (bool, error) {
 	key := source.Key()
 
 	internal := global
@@ -95,27 +413,64 @@ func Bind[T any](source BindingSource[T], options ...BindingOption) error {
 
 	generated := key.Generate()
 
-	if child, ok := source.(FollowingBindingSource[T]); ok {
-		parent, ok := internal[generated]
-		if ok {
-			child.SetPrevious(parent)
+	for {
+		previous, ok, revision := internal.entry(generated)
+		if !chain {
+			previous = nil
+		} else if !ok && internal.parent != nil {
+			previous, _, _ = internal.parent.binding(generated)
 		}
+
+		binding, err := newBinding[T](source, previous, options)
+		if err != nil {
+			return false, err
+		}
+
+		replaced, stored := internal.swap(generated, binding, revision, chain)
+		if stored {
+			return replaced, nil
+		}
+	}
+}
+
+// newBinding makes the Binding from the BindingSource and all instances of
+// BindingOption. It is executed without holding the lock of the Container, as
+// BindingSource can execute user's code, like ProviderMethod does.
+//
+// The previous Binding is always passed to FollowingBindingSource, even when it
+// is nil, so the same BindingSource can be used multiple times without keeping
+// the previous Binding from the earlier usage.
+func newBinding[T any](source BindingSource[T], previous Binding, options []BindingOption) (Binding, error) {
+	if child, ok := source.(FollowingBindingSource[T]); ok {
+		child.SetPrevious(previous)
 	}
 
 	binding, err := source.Binding()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	var conditions []condition
 	for _, option := range options {
 		binding, err = option.Binding(binding)
 		if err != nil {
-			return err
+			return nil, err
+		}
+
+		if conditional, ok := option.(conditionalOption); ok {
+			conditions = append(conditions, conditional.condition())
 		}
 	}
 
-	internal[generated] = binding
-	return nil
+	if len(conditions) > 0 {
+		binding = &conditionalBinding{
+			current:    binding,
+			previous:   previous,
+			conditions: conditions,
+		}
+	}
+
+	return binding, nil
 }
 
 // MustBind wraps Bind method, by making sure error is not returned as an argument.
@@ -128,10 +483,109 @@ func MustBind[T any](source BindingSource[T], options ...BindingOption) {
 	}
 }
 
+// Replace executes the same logic as Bind method, by overriding the Binding
+// for the same Key inside the Container. It reports whether the Container
+// already had the Binding for that Key. Binding instances from the parent
+// Container are not affected, but they are shadowed by the new Binding.
+//
+// Unlike Bind method, it never extends slices and maps defined with InSlice
+// and InMap methods, but it starts the new ones instead.
+//
+// Example:
+// replaced, err := genjector.Replace[ReplaceInterface](genjector.AsPointer[ReplaceInterface, *ReplaceStruct]())
+func Replace[T any](source BindingSource[T], options ...BindingOption) (bool, error) {
+	return bind[T](source, false, options...)
+}
+
+// Unbind removes the Binding for desired interface (or struct) from the Container.
+// It reports whether the Binding was removed. Binding instances from the parent
+// Container are not affected, so they are used again after the Binding is removed
+// from the child Container.
+//
+// Slices and maps defined with InSlice and InMap methods are removed together
+// with all their members, by using the slice (or the map) type.
+//
+// Example:
+// removed := genjector.Unbind[UnbindInterface](genjector.WithAnnotation("first"))
+// removed = genjector.Unbind[[]SliceInterface]()
+//
+// Singletons that were already created are still released when the Container is closed.
+func Unbind[T any](options ...KeyOption) bool {
+	key := baseKeySource[T]{}.Key()
+
+	internal := global
+	for _, option := range options {
+		key = option.Key(key)
+		internal = option.Container(internal)
+	}
+
+	generated := key.Generate()
+
+	var removed bool
+	_ = internal.modify(func(bindings map[interface{}]Binding) error {
+		_, removed = bindings[generated]
+		delete(bindings, generated)
+		internal.touch(generated)
+		return nil
+	})
+
+	return removed
+}
+
+// IsBound reports whether the Binding for desired interface (or struct) is defined
+// inside the Container, or inside its parent Container. The fallback Binding used
+// by NewInstance method is not taken into account, as well as Binding instances
+// defined with WithCondition or WithProfile methods that are not active.
+//
+// Example:
+// bound := genjector.IsBound[OptionalInterface](genjector.WithAnnotation("first"))
+func IsBound[T any](options ...KeyOption) bool {
+	key := baseKeySource[T]{}.Key()
+
+	internal := global
+	for _, option := range options {
+		key = option.Key(key)
+		internal = option.Container(internal)
+	}
+
+	binding, _, ok := internal.binding(key.Generate())
+	if !ok {
+		return false
+	}
+
+	_, ok = internal.active(binding)
+	return ok
+}
+
+// LookupBinding delivers the Binding stored for desired interface (or struct) inside
+// the Container, or inside its parent Container, without creating any instance. The
+// Binding is delivered as it is stored, even when it is defined with WithCondition
+// (or WithProfile) method and it is not active. It reports false in case the Binding
+// is not defined.
+//
+// It is meant for tools that wrap or restore existing Binding instances,
+// like genjectortest package does.
+//
+// Example:
+// binding, ok := genjector.LookupBinding[RepositoryInterface](genjector.WithContainer(container))
+func LookupBinding[T any](options ...KeyOption) (Binding, bool) {
+	key := baseKeySource[T]{}.Key()
+
+	internal := global
+	for _, option := range options {
+		key = option.Key(key)
+		internal = option.Container(internal)
+	}
+
+	binding, _, ok := internal.binding(key.Generate())
+	return binding, ok
+}
+
 // NewInstance executes complete logic for initializing value (or pointer) for
 // desired interface (or struct). By default, it uses Binding instance from default
 // inner Container. If such Binding can not be found, it tries to make its own
-// fallback Binding.
+// fallback Binding. In case the Container has a parent, the parent is checked
+// before the fallback Binding is made.
 //
 // All instances of BindingOption are optional.
 //
@@ -140,41 +594,240 @@ func MustBind[T any](source BindingSource[T], options ...BindingOption) {
 // as for pointers it returns nil value. That means that pointer Binding
 // should be always defined.
 func NewInstance[T any](options ...KeyOption) (T, error) {
-	var empty T
-	source := &baseKeySource[T]{}
+	return NewInstanceContext[T](context.Background(), options...)
+}
 
-	key := source.Key()
+// NewInstanceContext executes the same logic as NewInstance method, by passing
+// the context.Context to every ContextProviderMethod used during initialization.
+//
+// When it is executed with the context.Context received inside ContextProviderMethod
+// (or Init method with context.Context), by default it uses the Container where
+// the outer Binding is defined, as well as the Scope used for the outer instance.
+// In that case, it returns CycleError if the same Binding is already in the process
+// of initialization.
+//
+// Example:
+//
+//	err := genjector.Bind(genjector.AsContextProvider[ContextInterface](func(ctx context.Context) (*ContextStruct, error) {
+//	  inner, err := genjector.NewInstanceContext[InnerInterface](ctx)
+//	  if err != nil {
+//	    return nil, err
+//	  }
+//
+//	  return &ContextStruct{
+//	    inner: inner,
+//	  }, nil
+//	}))
+func NewInstanceContext[T any](ctx context.Context, options ...KeyOption) (T, error) {
+	if instance, ok, err := resolveDirect[T](ctx, options); ok {
+		return instance, err
+	}
 
-	internal := global
+	return resolveAs[T](newResolutionContext[T](ctx, options), getFallbackBinding[T])
+}
+
+// NewOptionalInstance executes the same logic as NewInstance method, but without
+// using the fallback Binding. In case Binding for desired interface (or struct) is not
+// defined, it delivers an empty value of type T and false, so an instance defined with
+// an empty value can be distinguished from a missing one. Errors that occur while
+// the instance is initialized are still returned.
+//
+// Example:
+// instance, ok, err := genjector.NewOptionalInstance[MetricsInterface]()
+//
+// To only check if the Binding is defined, IsBound method should be used.
+func NewOptionalInstance[T any](options ...KeyOption) (T, bool, error) {
+	return NewOptionalInstanceContext[T](context.Background(), options...)
+}
+
+// NewOptionalInstanceContext executes the same logic as NewOptionalInstance method,
+// by passing the context.Context in the same way as NewInstanceContext method does.
+func NewOptionalInstanceContext[T any](ctx context.Context, options ...KeyOption) (T, bool, error) {
+	if instance, ok, err := resolveDirect[T](ctx, options); ok {
+		return instance, err == nil, err
+	}
+
+	missing := false
+	instance, err := resolveAs[T](newResolutionContext[T](ctx, options), func() (Binding, error) {
+		missing = true
+		return nil, ErrNotBound
+	})
+	if missing {
+		return instance, false, nil
+	}
+
+	return instance, err == nil, err
+}
+
+// newResolutionContext delivers a resolutionContext for a type T. In case the
+// context.Context holds the resolution, it is used as the preceding one, and
+// its Container and Scope are used by default. Otherwise, while resolutions
+// are traced, the last one in progress on the same goroutine is used as
+// the preceding one.
+func newResolutionContext[T any](ctx context.Context, options []KeyOption) *resolutionContext {
+	key := baseKeySource[T]{}.Key()
+
+	child := &resolutionContext{
+		Context: ctx,
+		resolution: resolution{
+			container: global,
+		},
+	}
+	if parent, ok := ctx.Value(resolutionKey{}).(*resolution); ok {
+		child.resolution = *parent
+		child.resolution.previous = parent
+	} else if tracing() {
+		child.resolution.previous = tracedResolution()
+	}
+
+	resolution := &child.resolution
 	for _, option := range options {
 		key = option.Key(key)
-		internal = option.Container(internal)
+		resolution.container = option.Container(resolution.container)
+		if resolutionOption, ok := option.(resolutionOption); ok {
+			resolutionOption.apply(resolution)
+		}
 	}
 
-	generated := key.Generate()
+	resolution.key = key
+	return child
+}
 
-	binding, ok := internal[generated]
-	if !ok {
-		var err error
-		binding, err = getFallbackBinding[T]()
-		if err != nil {
-			return empty, err
+// directBinding represents a Binding that can deliver its instance without
+// the context.Context, like pointerBinding for a struct without Init method
+// with the context.Context, or ProviderMethod. In case it can not, it reports
+// false, so the complete resolution should be used.
+type directBinding interface {
+	direct(key Key) (interface{}, bool, error)
+}
+
+// resolveDirect delivers the instance of type T without making the resolution,
+// in case nothing from the resolution is used: there is no preceding resolution
+// inside the context.Context, no Scope (or other resolutionOption) is used,
+// there is no Interceptor, resolutions are not traced, and the Binding is
+// a directBinding. Otherwise, it reports false, so the complete resolution
+// should be used.
+func resolveDirect[T any](ctx context.Context, options []KeyOption) (T, bool, error) {
+	var empty T
+
+	key := baseKeySource[T]{}.Key()
+	container := global
+	for _, option := range options {
+		if _, ok := option.(resolutionOption); ok {
+			return empty, false, nil
 		}
+		key = option.Key(key)
+		container = option.Container(container)
+	}
+
+	if ctx.Value(resolutionKey{}) != nil || container.hasInterceptors() || tracing() {
+		return empty, false, nil
 	}
 
-	instance, err := binding.Instance(true)
+	binding, _, ok := container.binding(key.Generate())
+	if ok {
+		binding, ok = container.active(binding)
+	}
+	if !ok {
+		return empty, false, nil
+	}
+
+	direct, ok := binding.(directBinding)
+	if !ok {
+		return empty, false, nil
+	}
+
+	instance, ok, err := directInstance(direct, key)
+	if !ok || err != nil {
+		return empty, ok, err
+	}
+
+	result, ok := instance.(T)
+	if !ok {
+		return empty, true, newTypeMismatchError[T](key, instance)
+	}
+
+	return result, true, nil
+}
+
+// directInstance executes direct method of the directBinding, by marking
+// it as unfinished resolution, as it might execute NewInstance method.
+func directInstance(direct directBinding, key Key) (interface{}, bool, error) {
+	traces.running.Add(1)
+	defer traces.running.Add(-1)
+
+	return direct.direct(key)
+}
+
+// resolveAs executes resolve method for the resolution stored inside
+// the resolutionContext, and delivers the instance as type T.
+func resolveAs[T any](ctx *resolutionContext, fallback func() (Binding, error)) (T, error) {
+	var empty T
+
+	instance, err := resolve(ctx, fallback)
 	if err != nil {
 		return empty, err
 	}
 
 	result, ok := instance.(T)
 	if !ok {
-		return empty, fmt.Errorf(`invalid binding is defined for key "%v"`, generated)
+		return empty, newTypeMismatchError[T](ctx.resolution.key, instance)
 	}
 
 	return result, nil
 }
 
+// resolve executes the part of NewInstanceContext method that does not depend
+// on the type of the instance. It finds the Binding for the Key defined in
+// the resolution, or makes the fallback Binding, and delivers its instance.
+func resolve(ctx *resolutionContext, fallback func() (Binding, error)) (interface{}, error) {
+	resolution := &ctx.resolution
+	requested := resolution.container
+
+	binding, container, ok := requested.binding(resolution.key.Generate())
+	if ok {
+		binding, ok = requested.active(binding)
+	}
+	if ok {
+		resolution.container = container
+	} else {
+		var err error
+		binding, err = fallback()
+		if err != nil {
+			return nil, fmt.Errorf(`%w for key "%s"`, err, resolution.key)
+		}
+
+		if (resolution.strict || requested.isStrict()) && !isImplicit(binding) {
+			return nil, fmt.Errorf(`%w for key "%s"`, ErrNotBound, resolution.key)
+		}
+	}
+
+	err := resolution.cycle()
+	if err != nil {
+		return nil, err
+	}
+
+	if previous := resolution.previous; previous != nil && previous.container != nil {
+		previous.container.recorder.record(previous.key, resolution.key)
+	}
+
+	trace := enterTrace(resolution)
+	defer trace.leave()
+
+	var instance interface{}
+	if requested.hasInterceptors() {
+		instance, err = requested.intercept(ctx, resolution.key, binding)
+	} else {
+		instance, err = binding.Instance(ctx, true)
+	}
+
+	if err == nil && resolution.validation != nil && lifetimeOf(binding) == LifetimeTransient {
+		resolution.validation.track(instance)
+	}
+
+	return instance, err
+}
+
 // MustNewInstance wraps NewInstance method, by making sure error is not returned as an argument.
 //
 // Still, in case of error, it panics.
@@ -187,26 +840,18 @@ func MustNewInstance[T any](options ...KeyOption) T {
 	return instance
 }
 
-// Clean creates a new instance of inner Container.
+// Clean removes all Binding instances from inner Container.
 func Clean() {
-	global = NewContainer()
+	global.clean()
 }
 
-// getFallbackBinding creates a new instance of fallback Binding.
+// getFallbackBinding creates a new instance of fallback Binding. In case
+// the fallback Binding can not be made, like for interfaces, it returns ErrNotBound.
 func getFallbackBinding[T any]() (Binding, error) {
-	var binding Binding
-	var err error
-
-	source := AsValue[T, T]()
-	binding, err = source.Binding()
-	if err == nil {
-		instance, err := binding.Instance(false)
-		if err == nil {
-			if _, ok := instance.(T); ok {
-				return binding, nil
-			}
-		}
+	binding, err := AsValue[T, T]().Binding()
+	if err != nil {
+		return nil, ErrNotBound
 	}
 
-	return nil, err
+	return binding, nil
 }
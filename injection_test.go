@@ -1,11 +1,22 @@
 package genjector
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"reflect"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
+func newTestContainer(bindings map[interface{}]Binding) *Container {
+	container := NewContainer()
+	container.bindings.Store(&bindings)
+	return container
+}
+
 func TestKey_Generate(t *testing.T) {
 	key := Key{}
 	generated := key.Generate()
@@ -31,9 +42,46 @@ func TestKey_Generate(t *testing.T) {
 	}
 }
 
+func TestKey_String(t *testing.T) {
+	tests := []struct {
+		key      Key
+		expected string
+	}{
+		{key: Key{Value: (*int)(nil)}, expected: "int"},
+		{key: Key{Value: (*int)(nil), Annotation: "annotation"}, expected: "int@annotation"},
+		{key: Key{Value: (*testStruct)(nil)}, expected: "github.com/ompluscator/genjector.testStruct"},
+		{key: Key{Value: (**testStruct)(nil), Annotation: "primary"}, expected: "*github.com/ompluscator/genjector.testStruct@primary"},
+		{key: Key{Value: (*[]testDependency)(nil)}, expected: "[]github.com/ompluscator/genjector.testDependency"},
+		{key: Key{Value: (*map[string]*testStruct)(nil)}, expected: "map[string]*github.com/ompluscator/genjector.testStruct"},
+		{key: Key{Value: (*[2]<-chan error)(nil)}, expected: "[2]<-chan error"},
+		{key: Key{Value: "value"}, expected: "string"},
+		{key: Key{}, expected: "<nil>"},
+	}
+
+	for _, test := range tests {
+		if test.key.String() != test.expected {
+			t.Errorf("expected %s, got %s", test.expected, test.key.String())
+		}
+	}
+}
+
+func Test_keyFromGenerated(t *testing.T) {
+	keys := []Key{
+		{Value: (*int)(nil)},
+		{Value: (*int)(nil), Annotation: "annotation"},
+	}
+
+	for _, key := range keys {
+		result := keyFromGenerated(key.Generate())
+		if result != key {
+			t.Errorf("expected %v, got %v", key, result)
+		}
+	}
+}
+
 func TestNewContainer(t *testing.T) {
 	container := NewContainer()
-	if !reflect.DeepEqual(container, Container{}) {
+	if !reflect.DeepEqual(container.snapshot(), map[interface{}]Binding{}) {
 		t.Errorf("expected concrete value, got %v", container)
 	}
 }
@@ -67,7 +115,7 @@ func TestBind_bindingOptionError(t *testing.T) {
 		key: func(key Key) Key {
 			return key
 		},
-		container: func(container Container) Container {
+		container: func(container *Container) *Container {
 			return NewContainer()
 		},
 	})
@@ -78,7 +126,7 @@ func TestBind_bindingOptionError(t *testing.T) {
 
 func TestBind_binding_success(t *testing.T) {
 	inner := NewContainer()
-	if !reflect.DeepEqual(inner, Container{}) {
+	if !reflect.DeepEqual(inner.snapshot(), map[interface{}]Binding{}) {
 		t.Errorf("expected concrete value, got %v", inner)
 	}
 
@@ -103,7 +151,7 @@ func TestBind_binding_success(t *testing.T) {
 				Value:      key.Value.(string) + "2",
 			}
 		},
-		container: func(Container) Container {
+		container: func(*Container) *Container {
 			return inner
 		},
 	})
@@ -111,7 +159,7 @@ func TestBind_binding_success(t *testing.T) {
 		t.Errorf("expected nil, got error %v", err)
 	}
 
-	if !reflect.DeepEqual(inner, Container{
+	if !reflect.DeepEqual(inner.snapshot(), map[interface{}]Binding{
 		[2]interface{}{
 			"firstsecond",
 			"12",
@@ -177,13 +225,13 @@ func TestNewInstance_defaultError(t *testing.T) {
 }
 
 func TestNewInstance_success(t *testing.T) {
-	inner := Container{
+	inner := newTestContainer(map[interface{}]Binding{
 		(*int)(nil): &testBinding{
 			instance: func(initialize bool) (interface{}, error) {
 				return nil, errors.New("error")
 			},
 		},
-	}
+	})
 
 	instance, err := NewInstance[int](WithContainer(inner))
 	if err == nil {
@@ -196,13 +244,13 @@ func TestNewInstance_success(t *testing.T) {
 }
 
 func TestNewInstance_invalid(t *testing.T) {
-	inner := Container{
+	inner := newTestContainer(map[interface{}]Binding{
 		(*int)(nil): &testBinding{
 			instance: func(initialize bool) (interface{}, error) {
 				return "value", nil
 			},
 		},
-	}
+	})
 
 	instance, err := NewInstance[int](WithContainer(inner))
 	if err == nil {
@@ -215,13 +263,13 @@ func TestNewInstance_invalid(t *testing.T) {
 }
 
 func TestNewInstance_simple_success(t *testing.T) {
-	inner := Container{
+	inner := newTestContainer(map[interface{}]Binding{
 		(*int)(nil): &testBinding{
 			instance: func(initialize bool) (interface{}, error) {
 				return 10, nil
 			},
 		},
-	}
+	})
 
 	instance, err := NewInstance[int](WithContainer(inner))
 	if err != nil {
@@ -234,13 +282,13 @@ func TestNewInstance_simple_success(t *testing.T) {
 }
 
 func TestNewInstance_complex_success(t *testing.T) {
-	inner := Container{
+	inner := newTestContainer(map[interface{}]Binding{
 		[2]interface{}{"annotation", nil}: &testBinding{
 			instance: func(initialize bool) (interface{}, error) {
 				return 10, nil
 			},
 		},
-	}
+	})
 
 	instance, err := NewInstance[int](&testKeyOption{
 		key: func(key Key) Key {
@@ -248,7 +296,7 @@ func TestNewInstance_complex_success(t *testing.T) {
 				Annotation: "annotation",
 			}
 		},
-		container: func(container Container) Container {
+		container: func(container *Container) *Container {
 			return inner
 		},
 	})
@@ -260,3 +308,670 @@ func TestNewInstance_complex_success(t *testing.T) {
 		t.Errorf("expected 10, got %v", instance)
 	}
 }
+
+func TestContainer_concurrent(t *testing.T) {
+	inner := NewContainer()
+
+	var group sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		group.Add(2)
+		go func(value int) {
+			defer group.Done()
+
+			err := Bind[int](InSlice[int](AsInstance[int](value)), WithContainer(inner))
+			if err != nil {
+				t.Errorf("expected nil, got error %s", err)
+			}
+		}(i)
+		go func() {
+			defer group.Done()
+
+			_, err := NewInstance[[]int](WithContainer(inner))
+			if err != nil {
+				t.Errorf("expected nil, got error %s", err)
+			}
+		}()
+	}
+	group.Wait()
+
+	instance, err := NewInstance[[]int](WithContainer(inner))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+
+	if len(instance) != 50 {
+		t.Errorf("expected 50 items, got %d", len(instance))
+	}
+}
+
+func TestBind_reentrant(t *testing.T) {
+	inner := NewContainer()
+
+	done := make(chan error)
+	go func() {
+		done <- Bind[int](AsProvider[int](func() (int, error) {
+			return 10, Bind[string](AsInstance[string]("value"), WithContainer(inner))
+		}), WithContainer(inner))
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("expected nil, got error %s", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected Bind inside ProviderMethod not to block")
+	}
+
+	if !IsBound[string](WithContainer(inner)) {
+		t.Error("expected inner binding to be defined")
+	}
+}
+
+type testDirectStruct struct {
+	value string
+}
+
+type testDirectInitStruct struct {
+	value string
+}
+
+func (s *testDirectInitStruct) Init() {
+	s.value = "value"
+}
+
+func TestNewInstance_direct(t *testing.T) {
+	Clean()
+	defer Clean()
+
+	MustBind[*testDirectStruct](AsPointer[*testDirectStruct, *testDirectStruct]())
+	MustBind[int](AsInstance[int](10))
+
+	allocations := testing.AllocsPerRun(100, func() {
+		_, _ = NewInstance[*testDirectStruct]()
+	})
+	if allocations > 1 {
+		t.Errorf(`expected at most 1 allocation, got: %v`, allocations)
+	}
+
+	allocations = testing.AllocsPerRun(100, func() {
+		_, _ = NewInstance[int]()
+	})
+	if allocations > 0 {
+		t.Errorf(`expected no allocations, got: %v`, allocations)
+	}
+
+	MustBind[*testDirectInitStruct](AsPointer[*testDirectInitStruct, *testDirectInitStruct]())
+	allocations = testing.AllocsPerRun(100, func() {
+		_, _ = NewInstance[*testDirectInitStruct]()
+	})
+	if allocations > 1 {
+		t.Errorf(`expected at most 1 allocation, got: %v`, allocations)
+	}
+	if instance := MustNewInstance[*testDirectInitStruct](); instance.value != "value" {
+		t.Errorf(`expected initialized instance, got: %v`, instance)
+	}
+
+	MustBind[string](AsProvider[string](func() (string, error) {
+		return "value", nil
+	}))
+	allocations = testing.AllocsPerRun(100, func() {
+		_, _ = NewInstance[string]()
+	})
+	if allocations > 1 {
+		t.Errorf(`expected at most 1 allocation, got: %v`, allocations)
+	}
+
+	var failed bool
+	MustBind[bool](AsProvider[bool](func() (bool, error) {
+		if failed {
+			return false, errTestInit
+		}
+		return true, nil
+	}))
+	failed = true
+	_, ok, err := resolveDirect[bool](context.Background(), nil)
+	var providerErr *ProviderError
+	if !ok || !errors.As(err, &providerErr) || providerErr.Key != (Key{Value: (*bool)(nil)}) {
+		t.Errorf(`expected ProviderError for the key, got: %v`, err)
+	}
+
+	inner := NewContainer()
+	MustBind[*testDirectStruct](AsPointer[*testDirectStruct, *testDirectStruct](), WithContainer(inner))
+	MustBind[*testSingletonStruct](AsPointer[*testSingletonStruct, *testSingletonStruct](), AsSingleton(), WithContainer(inner))
+
+	_, ok, _ = resolveDirect[*testSingletonStruct](context.Background(), []KeyOption{WithContainer(inner)})
+	if ok {
+		t.Error("expected singleton with Init method not to be resolved directly before it is created")
+	}
+
+	first := MustNewInstance[*testSingletonStruct](WithContainer(inner))
+	second, ok, _ := resolveDirect[*testSingletonStruct](context.Background(), []KeyOption{WithContainer(inner)})
+	if !ok || first != second {
+		t.Error("expected created singleton to be resolved directly")
+	}
+
+	_, ok, _ = resolveDirect[*testDirectStruct](context.Background(), []KeyOption{WithContainer(inner), InScope(inner.NewScope())})
+	if ok {
+		t.Error("expected Scope not to be resolved directly")
+	}
+
+	inner.AddInterceptor(func(ctx context.Context, invocation *Invocation, next func(context.Context) (interface{}, error)) (interface{}, error) {
+		return next(ctx)
+	})
+	_, ok, _ = resolveDirect[*testDirectStruct](context.Background(), []KeyOption{WithContainer(inner)})
+	if ok {
+		t.Error("expected Interceptor not to be resolved directly")
+	}
+}
+
+func TestClean(t *testing.T) {
+	MustBind[int](AsInstance[int](10))
+
+	instance := MustNewInstance[int]()
+	if instance != 10 {
+		t.Errorf("expected 10, got %v", instance)
+	}
+
+	Clean()
+
+	instance = MustNewInstance[int]()
+	if instance != 0 {
+		t.Errorf("expected 0, got %v", instance)
+	}
+}
+
+func TestNewChildContainer(t *testing.T) {
+	parent := NewContainer()
+	child := NewChildContainer(parent)
+	if child.parent != parent {
+		t.Error("expected parent container to be set")
+	}
+
+	if !reflect.DeepEqual(child.snapshot(), map[interface{}]Binding{}) {
+		t.Errorf("expected concrete value, got %v", child.snapshot())
+	}
+}
+
+func TestNewInstance_childContainer(t *testing.T) {
+	parent := newTestContainer(map[interface{}]Binding{
+		(*int)(nil):    &instanceBinding[int]{instance: 10},
+		(*string)(nil): &instanceBinding[string]{instance: "parent"},
+	})
+	child := NewChildContainer(parent)
+
+	err := Bind[string](AsInstance[string]("child"), WithContainer(child))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+
+	number, err := NewInstance[int](WithContainer(child))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+	if number != 10 {
+		t.Errorf("expected 10, got %v", number)
+	}
+
+	value, err := NewInstance[string](WithContainer(child))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+	if value != "child" {
+		t.Errorf("expected child, got %v", value)
+	}
+
+	value, err = NewInstance[string](WithContainer(parent))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+	if value != "parent" {
+		t.Errorf("expected parent, got %v", value)
+	}
+}
+
+func TestBind_childContainerPrevious(t *testing.T) {
+	parent := NewContainer()
+	child := NewChildContainer(parent)
+
+	err := Bind[int](InSlice[int](AsInstance[int](1)), WithContainer(parent))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+
+	err = Bind[int](InSlice[int](AsInstance[int](2)), WithContainer(child))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+
+	instance, err := NewInstance[[]int](WithContainer(child))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+	if !reflect.DeepEqual(instance, []int{1, 2}) {
+		t.Errorf("expected concrete value, got %v", instance)
+	}
+
+	instance, err = NewInstance[[]int](WithContainer(parent))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+	if !reflect.DeepEqual(instance, []int{1}) {
+		t.Errorf("expected concrete value, got %v", instance)
+	}
+}
+
+func TestReplace(t *testing.T) {
+	parent := NewContainer()
+	child := NewChildContainer(parent)
+
+	MustBind[int](InSlice[int](AsInstance[int](1)), WithContainer(parent))
+	MustBind[int](InSlice[int](AsInstance[int](2)), WithContainer(child))
+	MustBind[string](AsInstance[string]("first"), WithContainer(child), WithAnnotation("annotation"))
+
+	replaced, err := Replace[int](InSlice[int](AsInstance[int](3)), WithContainer(child))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+	if !replaced {
+		t.Error("expected replaced binding")
+	}
+
+	instance, err := NewInstance[[]int](WithContainer(child))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+	if !reflect.DeepEqual(instance, []int{3}) {
+		t.Errorf("expected concrete value, got %v", instance)
+	}
+
+	replaced, err = Replace[string](AsInstance[string]("second"), WithContainer(child), WithAnnotation("annotation"))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+	if !replaced {
+		t.Error("expected replaced binding")
+	}
+
+	value, err := NewInstance[string](WithContainer(child), WithAnnotation("annotation"))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+	if value != "second" {
+		t.Errorf("expected concrete value, got %v", value)
+	}
+
+	replaced, err = Replace[bool](AsInstance[bool](true), WithContainer(child))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+	if replaced {
+		t.Error("expected new binding")
+	}
+
+	replaced, err = Replace[int](AsInstance[int]("value"), WithContainer(child))
+	if err == nil {
+		t.Error("expected error, got nil")
+	}
+	if replaced {
+		t.Error("expected no replaced binding")
+	}
+}
+
+func TestUnbind(t *testing.T) {
+	parent := NewContainer()
+	child := NewChildContainer(parent)
+
+	MustBind[int](InSlice[int](AsInstance[int](1)), WithContainer(parent))
+	MustBind[int](InSlice[int](AsInstance[int](2)), WithContainer(child))
+	MustBind[string](AsInstance[string]("value"), WithContainer(child), WithAnnotation("annotation"))
+
+	if Unbind[string](WithContainer(child)) {
+		t.Error("expected nothing to be removed")
+	}
+	if !Unbind[string](WithContainer(child), WithAnnotation("annotation")) {
+		t.Error("expected removed binding")
+	}
+	if IsBound[string](WithContainer(child), WithAnnotation("annotation")) {
+		t.Error("expected binding to be removed")
+	}
+
+	if !Unbind[[]int](WithContainer(child)) {
+		t.Error("expected removed binding")
+	}
+	instance, err := NewInstance[[]int](WithContainer(child))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+	if !reflect.DeepEqual(instance, []int{1}) {
+		t.Errorf("expected concrete value, got %v", instance)
+	}
+
+	MustBind[int](InSlice[int](AsInstance[int](3)), WithContainer(child))
+	instance, err = NewInstance[[]int](WithContainer(child))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+	if !reflect.DeepEqual(instance, []int{1, 3}) {
+		t.Errorf("expected concrete value, got %v", instance)
+	}
+}
+
+func TestIsBound(t *testing.T) {
+	parent := NewContainer()
+	child := NewChildContainer(parent)
+
+	MustBind[int](AsInstance[int](1), WithContainer(parent))
+	MustBind[string](InMap[string, string]("key", AsInstance[string]("value")), WithContainer(child))
+
+	if !IsBound[int](WithContainer(child)) {
+		t.Error("expected binding from parent container")
+	}
+	if IsBound[int](WithContainer(child), WithAnnotation("annotation")) {
+		t.Error("expected no binding with annotation")
+	}
+	if !IsBound[map[string]string](WithContainer(child)) {
+		t.Error("expected binding for map")
+	}
+	if IsBound[map[string]string](WithContainer(parent)) {
+		t.Error("expected no binding in parent container")
+	}
+	if IsBound[bool](WithContainer(child)) {
+		t.Error("expected no binding for fallback")
+	}
+}
+
+func TestLookupBinding(t *testing.T) {
+	parent := NewContainer()
+	child := NewChildContainer(parent)
+
+	MustBind[int](AsInstance[int](1), WithContainer(parent))
+	MustBind[string](AsInstance[string]("value"), WithContainer(child), WithProfile("test"))
+
+	binding, ok := LookupBinding[int](WithContainer(child))
+	if !ok {
+		t.Fatal("expected binding from parent container")
+	}
+	instance, err := binding.Instance(context.Background(), true)
+	if err != nil || instance != 1 {
+		t.Errorf(`expected 1, got: %v, %v`, instance, err)
+	}
+
+	if _, ok := LookupBinding[string](WithContainer(child)); !ok {
+		t.Error("expected inactive binding")
+	}
+	if _, ok := LookupBinding[int](WithContainer(child), WithAnnotation("annotation")); ok {
+		t.Error("expected no binding with annotation")
+	}
+	if _, ok := LookupBinding[bool](WithContainer(child)); ok {
+		t.Error("expected no binding for fallback")
+	}
+}
+
+type testContextKey struct{}
+
+func Test_resolutionFromContext(t *testing.T) {
+	result := resolutionFromContext(context.Background())
+	if !reflect.DeepEqual(result, &resolution{}) {
+		t.Errorf("expected empty resolution, got %v", result)
+	}
+
+	container := NewContainer()
+	ctx := &resolutionContext{
+		Context: context.WithValue(context.Background(), testContextKey{}, "value"),
+		resolution: resolution{
+			container: container,
+		},
+	}
+
+	result = resolutionFromContext(ctx)
+	if result.container != container {
+		t.Error("expected resolution from the context")
+	}
+	if ctx.Value(testContextKey{}) != "value" {
+		t.Error("expected value from the parent context")
+	}
+}
+
+func TestNewInstanceContext_nested(t *testing.T) {
+	parent := NewContainer()
+	child := NewChildContainer(parent)
+
+	err := Bind[int](AsInstance[int](10), WithContainer(parent))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+
+	err = Bind[int](AsInstance[int](20), WithContainer(child))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+
+	err = Bind[string](AsContextProvider[string](func(ctx context.Context) (string, error) {
+		number, err := NewInstanceContext[int](ctx)
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%s-%d", ctx.Value(testContextKey{}), number), nil
+	}), WithContainer(parent))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+
+	ctx := context.WithValue(context.Background(), testContextKey{}, "value")
+	instance, err := NewInstanceContext[string](ctx, WithContainer(child))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+
+	if instance != "value-10" {
+		t.Errorf("expected value-10, got %v", instance)
+	}
+}
+
+func TestNewInstanceContext_nestedScope(t *testing.T) {
+	inner := NewContainer()
+	scope := inner.NewScope()
+	defer scope.Close(context.Background())
+
+	err := Bind[*testStruct](AsPointer[*testStruct, *testStruct](), AsScoped(), WithContainer(inner))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+
+	err = Bind[[]*testStruct](AsContextProvider[[]*testStruct](func(ctx context.Context) ([]*testStruct, error) {
+		first, err := NewInstanceContext[*testStruct](ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		second, err := NewInstanceContext[*testStruct](ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*testStruct{first, second}, nil
+	}), WithContainer(inner))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+
+	instance, err := NewInstanceContext[[]*testStruct](context.Background(), InScope(scope))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+
+	if len(instance) != 2 || instance[0] != instance[1] {
+		t.Errorf("expected the same scoped instances, got %v", instance)
+	}
+}
+
+func TestNewInstance_initializeWithError(t *testing.T) {
+	inner := NewContainer()
+
+	err := Bind[*testFailingStruct](AsPointer[*testFailingStruct, *testFailingStruct](), WithContainer(inner))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+
+	instance, err := NewInstance[*testFailingStruct](WithContainer(inner))
+	if !errors.Is(err, errTestInit) {
+		t.Errorf("expected init error, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "testFailingStruct") {
+		t.Errorf("expected error with the key, got %v", err)
+	}
+	if instance != nil {
+		t.Errorf("expected nil, got %v", instance)
+	}
+}
+
+type testCycleFirst struct {
+	second *testCycleSecond
+}
+
+func (s *testCycleFirst) Init(ctx context.Context) error {
+	var err error
+	s.second, err = NewInstanceContext[*testCycleSecond](ctx, WithAnnotation("second"))
+	return err
+}
+
+type testCycleSecond struct {
+	first *testCycleFirst
+}
+
+func (s *testCycleSecond) Init(ctx context.Context) error {
+	var err error
+	s.first, err = NewInstanceContext[*testCycleFirst](ctx)
+	return err
+}
+
+func TestNewInstanceContext_cycle(t *testing.T) {
+	inner := NewContainer()
+
+	err := Bind[*testCycleFirst](AsPointer[*testCycleFirst, *testCycleFirst](), WithContainer(inner))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+
+	err = Bind[*testCycleSecond](AsPointer[*testCycleSecond, *testCycleSecond](), AsSingleton(), WithAnnotation("second"), WithContainer(inner))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+
+	instance, err := NewInstance[*testCycleFirst](WithContainer(inner))
+	if instance != nil {
+		t.Errorf("expected nil, got %v", instance)
+	}
+
+	var cycleErr *CycleError
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("expected cycle error, got %v", err)
+	}
+
+	if !reflect.DeepEqual(cycleErr.Path, []Key{
+		{Value: (*(*testCycleFirst))(nil)},
+		{Value: (*(*testCycleSecond))(nil), Annotation: "second"},
+		{Value: (*(*testCycleFirst))(nil)},
+	}) {
+		t.Errorf("expected concrete path, got %v", cycleErr.Path)
+	}
+}
+
+func TestNewInstanceContext_noCycle(t *testing.T) {
+	parent := NewContainer()
+	child := NewChildContainer(parent)
+
+	err := Bind[int](AsInstance[int](10), WithContainer(parent))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+
+	err = Bind[int](AsContextProvider[int](func(ctx context.Context) (int, error) {
+		value, err := NewInstanceContext[int](ctx, WithContainer(parent))
+		return value + 1, err
+	}), WithContainer(child))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+
+	instance, err := NewInstance[int](WithContainer(child))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+	if instance != 11 {
+		t.Errorf("expected 11, got %v", instance)
+	}
+}
+
+func TestNewOptionalInstance(t *testing.T) {
+	inner := NewContainer()
+	MustBind[int](AsInstance[int](0), WithContainer(inner))
+	MustBind[string](AsConstructor1[string](func(value testDependency) (string, error) {
+		return value.Value(), nil
+	}), WithContainer(inner))
+
+	value, ok, err := NewOptionalInstance[int](WithContainer(inner))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+	if !ok || value != 0 {
+		t.Errorf("expected bound empty value, got %v and %v", value, ok)
+	}
+
+	_, ok, err = NewOptionalInstance[int](WithContainer(inner), WithAnnotation("annotation"))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+	if ok {
+		t.Error("expected missing value")
+	}
+
+	dependency, ok, err := NewOptionalInstance[testDependency](WithContainer(inner))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+	if ok || dependency != nil {
+		t.Errorf("expected missing value, got %v", dependency)
+	}
+
+	_, ok, err = NewOptionalInstance[string](WithContainer(inner))
+	if !errors.Is(err, ErrNotBound) {
+		t.Errorf("expected ErrNotBound, got %v", err)
+	}
+	if ok {
+		t.Error("expected missing value")
+	}
+}
+
+func TestNewOptionalInstanceContext_nested(t *testing.T) {
+	inner := NewContainer()
+	MustBind[string](AsContextProvider[string](func(ctx context.Context) (string, error) {
+		value, ok, err := NewOptionalInstanceContext[int](ctx)
+		if err != nil {
+			return "", err
+		}
+		if !ok {
+			return "missing", nil
+		}
+		return fmt.Sprint(value), nil
+	}), WithContainer(inner))
+
+	value, err := NewInstance[string](WithContainer(inner))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+	if value != "missing" {
+		t.Errorf("expected missing, got %s", value)
+	}
+
+	MustBind[int](AsInstance[int](5), WithContainer(inner))
+	value, err = NewInstance[string](WithContainer(inner))
+	if err != nil {
+		t.Errorf("expected nil, got error %s", err)
+	}
+	if value != "5" {
+		t.Errorf("expected 5, got %s", value)
+	}
+}
@@ -0,0 +1,187 @@
+package genjector
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// Lifetime represents how long an instance delivered by a Binding lives.
+type Lifetime int
+
+const (
+	// LifetimeTransient is used for Binding instances that deliver a new instance every time.
+	LifetimeTransient Lifetime = iota
+	// LifetimeSingleton is used for Binding instances defined with AsSingleton method.
+	LifetimeSingleton
+	// LifetimeScoped is used for Binding instances defined with AsScoped method.
+	LifetimeScoped
+	// LifetimeInstance is used for Binding instances defined with AsInstance method.
+	LifetimeInstance
+)
+
+// String delivers a readable name of the Lifetime.
+//
+// It respects fmt.Stringer interface.
+func (l Lifetime) String() string {
+	switch l {
+	case LifetimeSingleton:
+		return "singleton"
+	case LifetimeScoped:
+		return "scoped"
+	case LifetimeInstance:
+		return "instance"
+	}
+	return "transient"
+}
+
+// lifetimeBinding represents a Binding that defines its own Lifetime,
+// like singletonBinding does.
+type lifetimeBinding interface {
+	lifetime() Lifetime
+}
+
+// lifetimeOf delivers the Lifetime of the Binding. In case Binding does not
+// define its own Lifetime, LifetimeTransient is delivered.
+func lifetimeOf(binding Binding) Lifetime {
+	if value, ok := binding.(lifetimeBinding); ok {
+		return value.lifetime()
+	}
+	return LifetimeTransient
+}
+
+// Invocation holds the information about a single execution of
+// the Instance method of a Binding, which is passed to the Interceptor.
+//
+// Duration holds the time spent inside the Instance method of the Binding,
+// without the time spent inside Interceptor instances. It is set after
+// the next method delivers the result.
+type Invocation struct {
+	Key      Key
+	Lifetime Lifetime
+	Duration time.Duration
+}
+
+// Interceptor defines a type of a method that is executed around every execution of the
+// Instance method of a Binding inside the Container. It receives the next method, which
+// executes the following Interceptor, or the Instance method of the Binding at the end.
+// The context.Context passed to the next method is used for the rest of the execution,
+// so it can be replaced with the derived one, for example to add a tracing span.
+// It delivers the instance and the error which are used instead of the ones from the next
+// method, so it can observe, as well as replace both of them, or skip the next method at all.
+//
+// Example:
+//
+//	interceptor := func(ctx context.Context, invocation *genjector.Invocation, next func(context.Context) (interface{}, error)) (interface{}, error) {
+//	  instance, err := next(ctx)
+//	  log.Printf("%s (%s) resolved in %s", invocation.Key, invocation.Lifetime, invocation.Duration)
+//	  return instance, err
+//	}
+type Interceptor func(ctx context.Context, invocation *Invocation, next func(context.Context) (interface{}, error)) (interface{}, error)
+
+// interceptors is a struct used for storing all Interceptor instances
+// of a Container.
+//
+// It is safe for concurrent usage. Reading is done without any locking, while
+// every change creates a new slice and atomically replaces the old one.
+type interceptors struct {
+	list atomic.Pointer[[]Interceptor]
+}
+
+// add stores new instances of Interceptor after the existing ones.
+func (i *interceptors) add(interceptors []Interceptor) {
+	for {
+		current := i.list.Load()
+
+		var list []Interceptor
+		if current != nil {
+			list = append(list, *current...)
+		}
+		list = append(list, interceptors...)
+
+		if i.list.CompareAndSwap(current, &list) {
+			return
+		}
+	}
+}
+
+// load delivers all stored Interceptor instances.
+func (i *interceptors) load() []Interceptor {
+	if list := i.list.Load(); list != nil {
+		return *list
+	}
+	return nil
+}
+
+// reset forgets all stored Interceptor instances.
+func (i *interceptors) reset() {
+	i.list.Store(nil)
+}
+
+// hasInterceptors checks if the Container, or any of its parents, has
+// at least one Interceptor.
+func (c *Container) hasInterceptors() bool {
+	for container := c; container != nil; container = container.parent {
+		if len(container.interceptors.load()) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// intercept executes the Instance method of the Binding, wrapped by all Interceptor
+// instances of the Container and its parents.
+func (c *Container) intercept(ctx context.Context, key Key, binding Binding) (interface{}, error) {
+	invocation := &Invocation{
+		Key:      key,
+		Lifetime: lifetimeOf(binding),
+	}
+
+	var list []Interceptor
+	for container := c; container != nil; container = container.parent {
+		list = append(list, container.interceptors.load()...)
+	}
+
+	next := func(ctx context.Context) (interface{}, error) {
+		start := time.Now()
+		instance, err := binding.Instance(ctx, true)
+		invocation.Duration = time.Since(start)
+		return instance, err
+	}
+	for i := len(list) - 1; i >= 0; i-- {
+		interceptor, inner := list[i], next
+		next = func(ctx context.Context) (interface{}, error) {
+			return interceptor(ctx, invocation, inner)
+		}
+	}
+
+	return next(ctx)
+}
+
+// AddInterceptor stores Interceptor instances inside the Container. They are executed
+// for all instances requested from the Container, as well as from all its child
+// containers. Interceptor instances of the Container wrap the ones of its parent,
+// and each of them wraps the ones added after it.
+//
+// Example:
+//
+//	customContainer.AddInterceptor(func(ctx context.Context, invocation *genjector.Invocation, next func(context.Context) (interface{}, error)) (interface{}, error) {
+//	  ctx, span := tracer.Start(ctx, invocation.Key.String())
+//	  defer span.End()
+//	  return next(ctx)
+//	})
+func (c *Container) AddInterceptor(interceptors ...Interceptor) {
+	c.interceptors.add(interceptors)
+}
+
+// AddInterceptor stores Interceptor instances inside the standard internal (global)
+// Container, in the same way as AddInterceptor method of the Container.
+//
+// Example:
+//
+//	genjector.AddInterceptor(func(ctx context.Context, invocation *genjector.Invocation, next func(context.Context) (interface{}, error)) (interface{}, error) {
+//	  return next(ctx)
+//	})
+func AddInterceptor(interceptors ...Interceptor) {
+	global.AddInterceptor(interceptors...)
+}
@@ -0,0 +1,193 @@
+package genjector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLifetime_String(t *testing.T) {
+	tests := map[Lifetime]string{
+		LifetimeTransient: "transient",
+		LifetimeSingleton: "singleton",
+		LifetimeScoped:    "scoped",
+		LifetimeInstance:  "instance",
+	}
+
+	for lifetime, expected := range tests {
+		if lifetime.String() != expected {
+			t.Errorf(`expected "%s", got: "%s"`, expected, lifetime.String())
+		}
+	}
+}
+
+func Test_lifetimeOf(t *testing.T) {
+	tests := []struct {
+		binding  Binding
+		expected Lifetime
+	}{
+		{binding: pointerBinding[int]{}, expected: LifetimeTransient},
+		{binding: &singletonBinding{}, expected: LifetimeSingleton},
+		{binding: &scopedBinding{}, expected: LifetimeScoped},
+		{binding: &instanceBinding[int]{}, expected: LifetimeInstance},
+	}
+
+	for _, test := range tests {
+		if lifetime := lifetimeOf(test.binding); lifetime != test.expected {
+			t.Errorf(`expected "%s", got: "%s"`, test.expected, lifetime)
+		}
+	}
+}
+
+func Test_interceptors(t *testing.T) {
+	var i interceptors
+	if len(i.load()) != 0 {
+		t.Error("expected no interceptors")
+	}
+
+	var wg sync.WaitGroup
+	for j := 0; j < 10; j++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			i.add([]Interceptor{nil})
+		}()
+	}
+	wg.Wait()
+
+	if len(i.load()) != 10 {
+		t.Errorf(`expected 10 interceptors, got: %d`, len(i.load()))
+	}
+
+	i.reset()
+	if len(i.load()) != 0 {
+		t.Error("expected no interceptors")
+	}
+}
+
+func TestContainer_AddInterceptor(t *testing.T) {
+	parent := NewContainer()
+	MustBind[int](AsInstance[int](1), WithContainer(parent))
+	MustBind[string](AsConstructor1[string](func(value int) (string, error) {
+		time.Sleep(time.Millisecond)
+		return "value", nil
+	}), AsSingleton(), WithContainer(parent), WithAnnotation("annotation"))
+
+	child := NewChildContainer(parent)
+
+	var order []string
+	var invocations []Invocation
+	parent.AddInterceptor(func(ctx context.Context, invocation *Invocation, next func(context.Context) (interface{}, error)) (interface{}, error) {
+		order = append(order, "parent")
+		instance, err := next(ctx)
+		order = append(order, "parent done")
+		invocations = append(invocations, *invocation)
+		return instance, err
+	})
+	child.AddInterceptor(func(ctx context.Context, invocation *Invocation, next func(context.Context) (interface{}, error)) (interface{}, error) {
+		order = append(order, "first")
+		return next(ctx)
+	}, func(ctx context.Context, invocation *Invocation, next func(context.Context) (interface{}, error)) (interface{}, error) {
+		order = append(order, "second")
+		return next(ctx)
+	})
+
+	instance, err := NewInstance[string](WithContainer(child), WithAnnotation("annotation"))
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+	if instance != "value" {
+		t.Errorf(`expected value, got: %s`, instance)
+	}
+
+	if len(invocations) != 2 {
+		t.Fatalf(`expected 2 invocations, got: %d`, len(invocations))
+	}
+	if invocations[0].Key != (Key{Value: (*int)(nil)}) || invocations[0].Lifetime != LifetimeInstance {
+		t.Errorf(`unexpected invocation: %v`, invocations[0])
+	}
+	if invocations[1].Key != (Key{Value: (*string)(nil), Annotation: "annotation"}) || invocations[1].Lifetime != LifetimeSingleton {
+		t.Errorf(`unexpected invocation: %v`, invocations[1])
+	}
+	if invocations[1].Duration < time.Millisecond || invocations[1].Duration < invocations[0].Duration {
+		t.Errorf(`expected outer duration to include inner one, got: %s and %s`, invocations[1].Duration, invocations[0].Duration)
+	}
+
+	expected := []string{"first", "second", "parent", "parent", "parent done", "parent done"}
+	if len(order) != len(expected) {
+		t.Fatalf(`expected order %v, got: %v`, expected, order)
+	}
+	for i := range expected {
+		if order[i] != expected[i] {
+			t.Errorf(`expected order %v, got: %v`, expected, order)
+		}
+	}
+}
+
+func TestContainer_AddInterceptor_replace(t *testing.T) {
+	inner := NewContainer()
+	MustBind[int](AsContextProvider[int](func(ctx context.Context) (int, error) {
+		return 0, errTestInit
+	}), WithContainer(inner))
+	MustBind[string](AsInstance[string]("value"), WithContainer(inner))
+
+	inner.AddInterceptor(func(ctx context.Context, invocation *Invocation, next func(context.Context) (interface{}, error)) (interface{}, error) {
+		if invocation.Key == (Key{Value: (*string)(nil)}) {
+			return nil, errors.New("replaced")
+		}
+
+		instance, err := next(ctx)
+		if errors.Is(err, errTestInit) {
+			return 10, nil
+		}
+		return instance, err
+	})
+
+	value, err := NewInstance[int](WithContainer(inner))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if value != 10 {
+		t.Errorf(`expected 10, got: %d`, value)
+	}
+
+	_, err = NewInstance[string](WithContainer(inner))
+	if err == nil || err.Error() != "replaced" {
+		t.Errorf(`expected replaced error, got: %v`, err)
+	}
+
+	inner.clean()
+	if inner.hasInterceptors() {
+		t.Error("expected no interceptors after clean")
+	}
+}
+
+func TestContainer_AddInterceptor_context(t *testing.T) {
+	inner := NewContainer()
+	MustBind[int](AsContextProvider[int](func(ctx context.Context) (int, error) {
+		value, _ := ctx.Value(testContextKey{}).(int)
+		return value, nil
+	}), WithContainer(inner))
+	MustBind[string](AsContextProvider[string](func(ctx context.Context) (string, error) {
+		value, err := NewInstanceContext[int](ctx)
+		return fmt.Sprint(value), err
+	}), WithContainer(inner))
+
+	inner.AddInterceptor(func(ctx context.Context, invocation *Invocation, next func(context.Context) (interface{}, error)) (interface{}, error) {
+		if invocation.Key == (Key{Value: (*string)(nil)}) {
+			ctx = context.WithValue(ctx, testContextKey{}, 10)
+		}
+		return next(ctx)
+	})
+
+	value, err := NewInstance[string](WithContainer(inner))
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+	if value != "10" {
+		t.Errorf(`expected 10, got: %s`, value)
+	}
+}
@@ -15,7 +15,7 @@ func (baseKeySource[T]) Key() Key {
 // Container returns the same instance of Container struct provided as an argument.
 //
 // It respects KeyOption interface.
-func (baseKeySource[T]) Container(container Container) Container {
+func (baseKeySource[T]) Container(container *Container) *Container {
 	return container
 }
 
@@ -34,7 +34,7 @@ func (sliceKeySource[T]) Key() Key {
 // Container returns the same instance of Container struct provided as an argument.
 //
 // It respects KeyOption interface.
-func (sliceKeySource[T]) Container(container Container) Container {
+func (sliceKeySource[T]) Container(container *Container) *Container {
 	return container
 }
 
@@ -53,7 +53,7 @@ func (mapKeySource[K, T]) Key() Key {
 // Container returns the same instance of Container struct provided as an argument.
 //
 // It respects KeyOption interface.
-func (mapKeySource[K, T]) Container(container Container) Container {
+func (mapKeySource[K, T]) Container(container *Container) *Container {
 	return container
 }
 
@@ -70,7 +70,7 @@ func (sameKeyOption) Key(key Key) Key {
 // Container returns the same instance of Container struct provided as an argument.
 //
 // It respects KeyOption interface.
-func (sameKeyOption) Container(container Container) Container {
+func (sameKeyOption) Container(container *Container) *Container {
 	return container
 }
 
@@ -92,13 +92,13 @@ func (o *annotatedKeyOption) Key(key Key) Key {
 // Container returns the same instance of Container struct provided as an argument.
 //
 // It respects KeyOption interface.
-func (*annotatedKeyOption) Container(container Container) Container {
+func (*annotatedKeyOption) Container(container *Container) *Container {
 	return container
 }
 
 // containerKeyOption is a concrete implementation for KeyOption interface.
 type containerKeyOption struct {
-	container Container
+	container *Container
 }
 
 // Key returns the same instance of Key struct provided as an argument.
@@ -111,6 +111,6 @@ func (*containerKeyOption) Key(key Key) Key {
 // Container returns an inner instance of Container.
 //
 // It respects KeyOption interface.
-func (o *containerKeyOption) Container(Container) Container {
+func (o *containerKeyOption) Container(*Container) *Container {
 	return o.container
 }
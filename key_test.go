@@ -32,9 +32,9 @@ func Test_baseKeySource_Key(t *testing.T) {
 }
 
 func Test_baseKeySource_Container(t *testing.T) {
-	container := map[interface{}]Binding{
+	container := newTestContainer(map[interface{}]Binding{
 		"something": nil,
-	}
+	})
 
 	first := new(baseKeySource[int]).Container(container)
 	second := new(baseKeySource[int]).Container(container)
@@ -80,9 +80,9 @@ func Test_sliceKeySource_Key(t *testing.T) {
 }
 
 func Test_sliceKeySource_Container(t *testing.T) {
-	container := map[interface{}]Binding{
+	container := newTestContainer(map[interface{}]Binding{
 		"something": nil,
-	}
+	})
 
 	first := new(sliceKeySource[int]).Container(container)
 	second := new(sliceKeySource[int]).Container(container)
@@ -132,9 +132,9 @@ func Test_mapKeySource_Key(t *testing.T) {
 }
 
 func Test_mapKeySource_Container(t *testing.T) {
-	container := map[interface{}]Binding{
+	container := newTestContainer(map[interface{}]Binding{
 		"something": nil,
-	}
+	})
 
 	first := new(mapKeySource[string, int]).Container(container)
 	second := new(mapKeySource[string, int]).Container(container)
@@ -163,9 +163,9 @@ func Test_sameKeyOption_Key(t *testing.T) {
 }
 
 func Test_sameKeyOption_Container(t *testing.T) {
-	container := map[interface{}]Binding{
+	container := newTestContainer(map[interface{}]Binding{
 		"something": nil,
-	}
+	})
 
 	first := new(sameKeyOption).Container(container)
 	second := new(sameKeyOption).Container(container)
@@ -203,9 +203,9 @@ func Test_annotatedKeyOption_Key(t *testing.T) {
 }
 
 func Test_annotatedKeyOption_Container(t *testing.T) {
-	container := map[interface{}]Binding{
+	container := newTestContainer(map[interface{}]Binding{
 		"something": nil,
-	}
+	})
 
 	first := new(annotatedKeyOption).Container(container)
 	second := new(annotatedKeyOption).Container(container)
@@ -229,23 +229,23 @@ func Test_containerKeyOption_Key(t *testing.T) {
 
 func Test_containerKeyOption_Container(t *testing.T) {
 	result := (&containerKeyOption{
-		container: Container{
+		container: newTestContainer(map[interface{}]Binding{
 			"first": nil,
-		},
-	}).Container(Container{
+		}),
+	}).Container(newTestContainer(map[interface{}]Binding{
 		"second": nil,
-	})
-	if !reflect.DeepEqual(Container{
+	}))
+	if !reflect.DeepEqual(map[interface{}]Binding{
 		"first": nil,
-	}, result) {
+	}, result.snapshot()) {
 		t.Error("containers are different")
 	}
 
 	result = (&containerKeyOption{
 		container: nil,
-	}).Container(Container{
+	}).Container(newTestContainer(map[interface{}]Binding{
 		"first": nil,
-	})
+	}))
 	if result != nil {
 		t.Error("containers are different")
 	}
@@ -0,0 +1,167 @@
+package genjector
+
+import (
+	"context"
+	"sync"
+)
+
+// handle holds the Container, the Scope, the annotation and the strict mode
+// of the resolution in which Lazy or Provider is delivered, so the instance
+// of type T can be resolved later in the same way.
+type handle[T any] struct {
+	container *Container
+	scope     *Scope
+	key       Key
+	strict    bool
+}
+
+// newHandle delivers a handle for the resolution stored inside the context.Context.
+// The annotation of the Lazy (or Provider) is used for the instance of type T.
+func newHandle[T any](ctx context.Context) *handle[T] {
+	resolution := resolutionFromContext(ctx)
+
+	container := resolution.container
+	if container == nil {
+		container = global
+	}
+
+	key := baseKeySource[T]{}.Key()
+	key.Annotation = resolution.key.Annotation
+
+	return &handle[T]{
+		container: container,
+		scope:     resolution.scope,
+		key:       key,
+		strict:    resolution.strict,
+	}
+}
+
+// instance delivers the instance of type T. It starts a new resolution, so
+// the instance can depend on the one that holds the Lazy (or Provider),
+// without causing CycleError.
+func (h *handle[T]) instance() (T, error) {
+	if h == nil {
+		var empty T
+		return empty, ErrNotInitialized
+	}
+
+	return resolveAs[T](&resolutionContext{
+		Context: context.Background(),
+		resolution: resolution{
+			container: h.container,
+			scope:     h.scope,
+			key:       h.key,
+			strict:    h.strict,
+		},
+	}, getFallbackBinding[T])
+}
+
+// lazyState holds the instance of type T shared between all copies of a Lazy.
+type lazyState[T any] struct {
+	handle   *handle[T]
+	mutex    sync.Mutex
+	done     bool
+	instance T
+}
+
+// Lazy delivers an instance of type T, which is resolved only once, on the first
+// execution of Get method. Lazy itself should be delivered by NewInstance method
+// (or used as a dependency of a constructor), without defining its Binding. In that
+// case it uses the same Container, Scope and annotation, as the Lazy itself.
+//
+// As the instance of type T is not resolved together with the Lazy, it can be
+// used to break cycles between Binding instances.
+//
+// Example:
+//
+//	err := genjector.Bind(genjector.AsConstructor1[ServiceInterface](func(repository genjector.Lazy[RepositoryInterface]) (*Service, error) {
+//	  return &Service{
+//	    repository: repository,
+//	  }, nil
+//	}))
+//
+// All copies of the Lazy share the same instance. It is safe for concurrent usage.
+type Lazy[T any] struct {
+	state *lazyState[T]
+}
+
+// Init stores the Container, the Scope and the annotation of the resolution.
+//
+// It respects InitializableWithContext interface.
+func (l *Lazy[T]) Init(ctx context.Context) error {
+	l.state = &lazyState[T]{
+		handle: newHandle[T](ctx),
+	}
+	return nil
+}
+
+// implicit marks the Lazy as always available, even in the strict mode.
+//
+// It respects implicitInstance interface.
+func (*Lazy[T]) implicit() {}
+
+// Get delivers the instance of type T. It is resolved on the first call, and
+// every next call delivers the same instance. In case of the error, the next
+// call tries to resolve the instance again.
+func (l Lazy[T]) Get() (T, error) {
+	if l.state == nil {
+		var empty T
+		return empty, ErrNotInitialized
+	}
+
+	l.state.mutex.Lock()
+	defer l.state.mutex.Unlock()
+
+	if l.state.done {
+		return l.state.instance, nil
+	}
+
+	instance, err := l.state.handle.instance()
+	if err != nil {
+		return instance, err
+	}
+
+	l.state.instance = instance
+	l.state.done = true
+	return instance, nil
+}
+
+// Provider delivers an instance of type T, which is resolved on every execution
+// of Get method. Provider itself should be delivered by NewInstance method (or used
+// as a dependency of a constructor), without defining its Binding. In that case it
+// uses the same Container, Scope and annotation, as the Provider itself.
+//
+// As the instance of type T is not resolved together with the Provider, it can be
+// used to break cycles between Binding instances.
+//
+// Example:
+//
+//	err := genjector.Bind(genjector.AsConstructor1[HandlerInterface](func(request genjector.Provider[RequestInterface]) (*Handler, error) {
+//	  return &Handler{
+//	    request: request,
+//	  }, nil
+//	}))
+//
+// It is safe for concurrent usage.
+type Provider[T any] struct {
+	handle *handle[T]
+}
+
+// Init stores the Container, the Scope and the annotation of the resolution.
+//
+// It respects InitializableWithContext interface.
+func (p *Provider[T]) Init(ctx context.Context) error {
+	p.handle = newHandle[T](ctx)
+	return nil
+}
+
+// implicit marks the Provider as always available, even in the strict mode.
+//
+// It respects implicitInstance interface.
+func (*Provider[T]) implicit() {}
+
+// Get delivers the instance of type T, by resolving it on every call.
+// Singletons still deliver the same instance.
+func (p Provider[T]) Get() (T, error) {
+	return p.handle.instance()
+}
@@ -0,0 +1,163 @@
+package genjector
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type testLazyFirst struct {
+	second Lazy[*testLazySecond]
+}
+
+type testLazySecond struct {
+	first *testLazyFirst
+}
+
+func TestLazy_Get(t *testing.T) {
+	inner := NewContainer()
+	MustBind[*testLazyFirst](AsConstructor1[*testLazyFirst](func(second Lazy[*testLazySecond]) (*testLazyFirst, error) {
+		return &testLazyFirst{
+			second: second,
+		}, nil
+	}), AsSingleton(), WithContainer(inner))
+	MustBind[*testLazySecond](AsConstructor1[*testLazySecond](func(first *testLazyFirst) (*testLazySecond, error) {
+		return &testLazySecond{
+			first: first,
+		}, nil
+	}), AsSingleton(), WithContainer(inner))
+
+	first, err := NewInstance[*testLazyFirst](WithContainer(inner))
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+
+	second, err := first.second.Get()
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+	if second.first != first {
+		t.Error("expected the same singleton instance")
+	}
+
+	again, err := first.second.Get()
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+	if again != second {
+		t.Error("expected the same instance")
+	}
+}
+
+func TestLazy_Get_annotation(t *testing.T) {
+	inner := NewContainer()
+	MustBind[string](AsInstance[string]("value"), WithContainer(inner), WithAnnotation("annotation"))
+
+	lazy, err := NewInstance[Lazy[string]](WithContainer(inner), WithAnnotation("annotation"))
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+
+	value, err := lazy.Get()
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+	if value != "value" {
+		t.Errorf(`expected value, got: %s`, value)
+	}
+}
+
+func TestLazy_Get_error(t *testing.T) {
+	inner := NewContainer()
+	calls := 0
+	MustBind[int](AsContextProvider[int](func(ctx context.Context) (int, error) {
+		calls++
+		if calls == 1 {
+			return 0, errTestInit
+		}
+		return calls, nil
+	}), WithContainer(inner))
+
+	lazy, err := NewInstance[Lazy[int]](WithContainer(inner))
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+
+	_, err = lazy.Get()
+	if !errors.Is(err, errTestInit) {
+		t.Errorf(`expected init error, got: %v`, err)
+	}
+
+	value, err := lazy.Get()
+	if err != nil || value != 2 {
+		t.Errorf(`expected 2, got: %v, %v`, value, err)
+	}
+
+	value, err = lazy.Get()
+	if err != nil || value != 2 {
+		t.Errorf(`expected 2, got: %v, %v`, value, err)
+	}
+
+	_, err = Lazy[int]{}.Get()
+	if !errors.Is(err, ErrNotInitialized) {
+		t.Errorf(`expected ErrNotInitialized, got: %v`, err)
+	}
+}
+
+func TestProvider_Get(t *testing.T) {
+	inner := NewContainer()
+	calls := 0
+	MustBind[int](AsContextProvider[int](func(ctx context.Context) (int, error) {
+		calls++
+		return calls, nil
+	}), AsScoped(), WithContainer(inner))
+
+	scope := inner.NewScope()
+	provider, err := NewInstance[Provider[int]](InScope(scope))
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+
+	for i := 0; i < 2; i++ {
+		value, err := provider.Get()
+		if err != nil || value != 1 {
+			t.Errorf(`expected 1, got: %v, %v`, value, err)
+		}
+	}
+
+	err = scope.Close(context.Background())
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+
+	_, err = provider.Get()
+	if !errors.Is(err, ErrScopeClosed) {
+		t.Errorf(`expected ErrScopeClosed, got: %v`, err)
+	}
+
+	_, err = Provider[int]{}.Get()
+	if !errors.Is(err, ErrNotInitialized) {
+		t.Errorf(`expected ErrNotInitialized, got: %v`, err)
+	}
+}
+
+func TestProvider_Get_transient(t *testing.T) {
+	inner := NewContainer()
+	calls := 0
+	MustBind[int](AsContextProvider[int](func(ctx context.Context) (int, error) {
+		calls++
+		return calls, nil
+	}), WithContainer(inner))
+
+	provider, err := NewInstance[Provider[int]](WithContainer(inner))
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+
+	for i := 1; i <= 3; i++ {
+		value, err := provider.Get()
+		if err != nil || value != i {
+			t.Errorf(`expected %d, got: %v, %v`, i, value, err)
+		}
+	}
+}
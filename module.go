@@ -0,0 +1,177 @@
+package genjector
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ModuleOption represents an option for the Module, used with NewModule method.
+type ModuleOption func(module *Module)
+
+// Module represents a named group of Binding definitions, that can include
+// other instances of Module. It is installed into the Container with Install method.
+//
+// Module only describes Binding definitions, so it can be installed into
+// multiple instances of Container, also concurrently.
+type Module struct {
+	name     string
+	bindings []func(container *Container) error
+	includes []*Module
+}
+
+// Name delivers the name of the Module.
+func (m *Module) Name() string {
+	return m.name
+}
+
+// NewModule delivers a new instance of Module with the name and
+// all Binding definitions and included modules from ModuleOption instances.
+//
+// Example:
+// module := genjector.NewModule(
+//
+//	"repository",
+//	genjector.WithBinding[RepositoryInterface](genjector.AsPointer[RepositoryInterface, *RepositoryStruct]()),
+//	genjector.WithModules(configModule),
+//
+// )
+func NewModule(name string, options ...ModuleOption) *Module {
+	module := &Module{
+		name: name,
+	}
+	for _, option := range options {
+		option(module)
+	}
+	return module
+}
+
+// WithBinding delivers a ModuleOption that adds the Binding definition to the Module.
+// It accepts the same arguments as Bind method, while the Container is always
+// the one where the Module is installed.
+//
+// The same BindingSource is used for every installation. As BindingSource instances
+// like the ones from InSlice and AsDecorator methods keep the previous Binding, the
+// Binding definition is made by one installation at a time.
+//
+// Example:
+// module := genjector.NewModule(
+//
+//	"repository",
+//	genjector.WithBinding[RepositoryInterface](genjector.AsPointer[RepositoryInterface, *RepositoryStruct](), genjector.AsSingleton()),
+//
+// )
+func WithBinding[T any](source BindingSource[T], options ...BindingOption) ModuleOption {
+	var mutex sync.Mutex
+	return func(module *Module) {
+		module.bindings = append(module.bindings, func(container *Container) error {
+			installed := make([]BindingOption, 0, len(options)+1)
+			installed = append(installed, options...)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			return Bind[T](source, append(installed, WithContainer(container))...)
+		})
+	}
+}
+
+// WithModules delivers a ModuleOption that includes other instances of Module into
+// the Module. Included modules are installed before Binding definitions of the Module.
+//
+// Example:
+// module := genjector.NewModule("service", genjector.WithModules(repositoryModule, configModule))
+func WithModules(modules ...*Module) ModuleOption {
+	return func(module *Module) {
+		module.includes = append(module.includes, modules...)
+	}
+}
+
+// installation is a struct used for storing all instances of Module
+// installed into the Container.
+//
+// It is safe for concurrent usage.
+type installation struct {
+	mutex   sync.Mutex
+	modules map[*Module]struct{}
+}
+
+// mark stores the Module as installed and reports whether it was not
+// installed before.
+func (i *installation) mark(module *Module) bool {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	if _, ok := i.modules[module]; ok {
+		return false
+	}
+
+	if i.modules == nil {
+		i.modules = map[*Module]struct{}{}
+	}
+	i.modules[module] = struct{}{}
+	return true
+}
+
+// reset forgets all installed instances of Module.
+func (i *installation) reset() {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	i.modules = nil
+}
+
+// install defines all Binding instances from included modules and then from
+// the Module itself. It continues after failed Binding definitions and returns
+// all errors joined together.
+func (m *Module) install(container *Container) error {
+	var result []error
+	for _, include := range m.includes {
+		if !container.installation.mark(include) {
+			continue
+		}
+		result = append(result, include.install(container))
+	}
+
+	for _, binding := range m.bindings {
+		err := binding(container)
+		if err != nil {
+			result = append(result, fmt.Errorf(`install failed for module "%s": %w`, m.name, err))
+		}
+	}
+
+	return errors.Join(result...)
+}
+
+// Install defines all Binding instances from all instances of Module inside the
+// Container. Each Module is installed only once per Container: in case the Module
+// is passed to Install method again, it returns ErrModuleInstalled, while the Module
+// included by multiple other instances of Module is installed only the first time.
+//
+// In case any Binding definition fails, Install continues with the rest of them
+// and returns all errors joined together.
+//
+// Example:
+// err := genjector.Install(customContainer, repositoryModule, serviceModule)
+func Install(container *Container, modules ...*Module) error {
+	var result []error
+	for _, module := range modules {
+		if !container.installation.mark(module) {
+			result = append(result, fmt.Errorf(`install failed for module "%s": %w`, module.name, ErrModuleInstalled))
+			continue
+		}
+		result = append(result, module.install(container))
+	}
+
+	return errors.Join(result...)
+}
+
+// MustInstall wraps Install method, by making sure error is not returned as an argument.
+//
+// Still, in case of error, it panics.
+func MustInstall(container *Container, modules ...*Module) {
+	err := Install(container, modules...)
+	if err != nil {
+		panic(err)
+	}
+}
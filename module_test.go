@@ -0,0 +1,194 @@
+package genjector
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestNewModule(t *testing.T) {
+	included := NewModule("included")
+	module := NewModule(
+		"module",
+		WithBinding[int](AsInstance[int](1)),
+		WithBinding[string](AsInstance[string]("value"), WithAnnotation("annotation")),
+		WithModules(included),
+	)
+
+	if module.Name() != "module" {
+		t.Errorf(`expected "module", got: "%s"`, module.Name())
+	}
+	if len(module.bindings) != 2 {
+		t.Errorf(`expected 2 bindings, got: %d`, len(module.bindings))
+	}
+	if len(module.includes) != 1 || module.includes[0] != included {
+		t.Errorf(`expected included module, got: %v`, module.includes)
+	}
+}
+
+func TestInstall(t *testing.T) {
+	shared := NewModule("shared", WithBinding[int](AsInstance[int](1)))
+	first := NewModule("first", WithModules(shared), WithBinding[string](AsInstance[string]("first")))
+	second := NewModule("second", WithModules(shared), WithBinding[string](AsInstance[string]("second"), WithAnnotation("second")))
+
+	container := NewContainer()
+	err := Install(container, first, second)
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+
+	value, err := NewInstance[int](WithContainer(container))
+	if err != nil || value != 1 {
+		t.Errorf(`expected 1, got: %d, %v`, value, err)
+	}
+
+	text, err := NewInstance[string](WithContainer(container), WithAnnotation("second"))
+	if err != nil || text != "second" {
+		t.Errorf(`expected "second", got: "%s", %v`, text, err)
+	}
+
+	if IsBound[int]() {
+		t.Error("expected module not to be installed into the global container")
+	}
+
+	err = Install(NewContainer(), first)
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+}
+
+func TestInstall_duplicate(t *testing.T) {
+	module := NewModule("module", WithBinding[int](AsInstance[int](1)))
+
+	container := NewContainer()
+	err := Install(container, module, module)
+	if !errors.Is(err, ErrModuleInstalled) {
+		t.Errorf(`expected ErrModuleInstalled, got: %v`, err)
+	}
+
+	err = Install(container, module)
+	if !errors.Is(err, ErrModuleInstalled) {
+		t.Errorf(`expected ErrModuleInstalled, got: %v`, err)
+	}
+
+	container.clean()
+	err = Install(container, module)
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+}
+
+func TestInstall_containers(t *testing.T) {
+	module := NewModule(
+		"module",
+		WithBinding[int](InSlice[int](AsInstance[int](1))),
+		WithBinding[string](AsDecorator[string](func(inner string) (string, error) {
+			return "decorated " + inner, nil
+		})),
+	)
+
+	first := NewContainer()
+	MustBind[string](AsInstance[string]("value"), WithContainer(first))
+	err := Install(first, module)
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+
+	second := NewContainer()
+	err = Install(second, module)
+	if !errors.Is(err, ErrNotBound) {
+		t.Errorf(`expected ErrNotBound, got: %v`, err)
+	}
+
+	for _, container := range []*Container{first, second} {
+		values, err := NewInstance[[]int](WithContainer(container))
+		if err != nil {
+			t.Fatalf(`expected nil, got: %v`, err)
+		}
+		if len(values) != 1 || values[0] != 1 {
+			t.Errorf(`expected [1], got: %v`, values)
+		}
+	}
+
+	value, err := NewInstance[string](WithContainer(first))
+	if err != nil || value != "decorated value" {
+		t.Errorf(`expected "decorated value", got: %v, %v`, value, err)
+	}
+	if IsBound[string](WithContainer(second)) {
+		t.Error("expected no decorator without existing binding")
+	}
+}
+
+func TestInstall_concurrent(t *testing.T) {
+	module := NewModule("module", WithBinding[int](InSlice[int](AsInstance[int](1))))
+
+	containers := make([]*Container, 10)
+	var wg sync.WaitGroup
+	for i := range containers {
+		containers[i] = NewContainer()
+		MustBind[int](InSlice[int](AsInstance[int](i)), WithContainer(containers[i]))
+
+		wg.Add(1)
+		go func(container *Container) {
+			defer wg.Done()
+			MustInstall(container, module)
+		}(containers[i])
+	}
+	wg.Wait()
+
+	for i, container := range containers {
+		values, err := NewInstance[[]int](WithContainer(container))
+		if err != nil {
+			t.Fatalf(`expected nil, got: %v`, err)
+		}
+		if len(values) != 2 || values[0] != i || values[1] != 1 {
+			t.Errorf(`expected [%d 1], got: %v`, i, values)
+		}
+	}
+}
+
+func TestInstall_errors(t *testing.T) {
+	included := NewModule("included", WithBinding[int](AsInstance[int]("value")))
+	module := NewModule(
+		"module",
+		WithModules(included),
+		WithBinding[string](AsInstance[string](1)),
+		WithBinding[bool](AsInstance[bool](true)),
+		WithBinding[float64](AsInstance[float64]("value")),
+	)
+
+	container := NewContainer()
+	err := Install(container, module)
+
+	var mismatchErr *TypeMismatchError
+	if !errors.As(err, &mismatchErr) {
+		t.Fatalf(`expected TypeMismatchError, got: %v`, err)
+	}
+
+	for _, expected := range []string{
+		`install failed for module "included": binding is not possible for key "int"`,
+		`install failed for module "module": binding is not possible for key "string"`,
+		`install failed for module "module": binding is not possible for key "float64"`,
+	} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf(`expected "%s" inside: %v`, expected, err)
+		}
+	}
+
+	if !IsBound[bool](WithContainer(container)) {
+		t.Error("expected binding after failed ones to be defined")
+	}
+}
+
+func TestMustInstall(t *testing.T) {
+	module := NewModule("module", WithBinding[int](AsInstance[int]("value")))
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic")
+		}
+	}()
+
+	MustInstall(NewContainer(), module)
+}
@@ -0,0 +1,188 @@
+package genjector
+
+import (
+	"context"
+	"sync"
+)
+
+// Scope represents a lifetime of instances defined with AsScoped method,
+// like a lifetime of a single HTTP request, or a single job. Every Binding
+// defined as scoped delivers only one instance per Scope, and that instance
+// is dropped when the Scope is closed.
+//
+// It is safe for concurrent usage.
+type Scope struct {
+	container *Container
+	mutex     sync.Mutex
+	instances map[*scopedBinding]*singletonBinding
+	closed    bool
+	disposer  disposer
+}
+
+// NewScope delivers a new instance of Scope, opened from the Container.
+//
+// Example:
+// scope := customContainer.NewScope()
+// defer scope.Close(ctx)
+func (c *Container) NewScope() *Scope {
+	return &Scope{
+		container: c,
+		instances: map[*scopedBinding]*singletonBinding{},
+	}
+}
+
+// NewScope delivers a new instance of Scope, opened from the standard
+// internal (global) Container.
+//
+// Example:
+// scope := genjector.NewScope()
+// defer scope.Close(ctx)
+func NewScope() *Scope {
+	return global.NewScope()
+}
+
+// Close ends the Scope and drops all instances stored inside it. Instances
+// that respect Disposable or io.Closer interface are released in reverse order
+// of their creation, and all errors are joined together.
+// Any later usage of the Scope in NewInstance method ends up with an error.
+func (s *Scope) Close(ctx context.Context) error {
+	s.mutex.Lock()
+	s.instances = nil
+	s.closed = true
+	s.mutex.Unlock()
+
+	return s.disposer.dispose(ctx)
+}
+
+// binding delivers a Binding that holds the single instance of
+// the scopedBinding for the Scope.
+func (s *Scope) binding(binding *scopedBinding) (Binding, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.closed {
+		return nil, ErrScopeClosed
+	}
+
+	instance, ok := s.instances[binding]
+	if !ok {
+		instance = &singletonBinding{
+			parent:   binding.parent,
+			disposer: &s.disposer,
+		}
+		s.instances[binding] = instance
+	}
+
+	return instance, nil
+}
+
+// scopedBinding is a concrete implementation for Binding interface.
+type scopedBinding struct {
+	parent Binding
+}
+
+// Instance delivers the instance stored inside the Scope used in NewInstance
+// method. In case there is no instance, it retrieves the instance from a child
+// Binding and stores it inside the Scope for the next calls.
+//
+// It respects Binding interface.
+func (b *scopedBinding) Instance(ctx context.Context, initialize bool) (interface{}, error) {
+	if !initialize {
+		return b.parent.Instance(ctx, initialize)
+	}
+
+	scope := resolutionFromContext(ctx).scope
+	if scope == nil {
+		return nil, ErrNoScope
+	}
+
+	binding, err := scope.binding(b)
+	if err != nil {
+		return nil, err
+	}
+
+	return binding.Instance(ctx, initialize)
+}
+
+// unwrap delivers the child Binding.
+//
+// It respects wrappingBinding interface.
+func (b *scopedBinding) unwrap() Binding {
+	return b.parent
+}
+
+// lifetime delivers LifetimeScoped.
+//
+// It respects lifetimeBinding interface.
+func (*scopedBinding) lifetime() Lifetime {
+	return LifetimeScoped
+}
+
+// AsScoped delivers a BindingOption that defines the instance of desired
+// Binding as a scoped one. That means only first time inside the Scope the Init
+// method (or ProviderMethod) will be called, and every next time inside the
+// same Scope the same instance will be delivered as a result of NewInstance method.
+//
+// Example:
+// err := genjector.Bind(
+//
+//	genjector.AsPointer[ScopedInterface, *ScopedStruct](),
+//	genjector.AsScoped(),
+//
+// )
+//
+// AsScoped should be only used as a BindingOption for Bind method, while
+// InScope should be used in NewInstance method.
+func AsScoped() BindingOption {
+	return &bindingOption{
+		bindingFunc: func(binding Binding) (Binding, error) {
+			return &scopedBinding{
+				parent: binding,
+			}, nil
+		},
+		keyOption: sameKeyOption{},
+	}
+}
+
+// scopeKeyOption is a concrete implementation for KeyOption interface.
+type scopeKeyOption struct {
+	scope *Scope
+}
+
+// Key returns the same instance of Key struct provided as an argument.
+//
+// It respects KeyOption interface.
+func (*scopeKeyOption) Key(key Key) Key {
+	return key
+}
+
+// Container returns the instance of Container from which the Scope is opened.
+//
+// It respects KeyOption interface.
+func (o *scopeKeyOption) Container(*Container) *Container {
+	return o.scope.container
+}
+
+// apply stores the Scope for the NewInstance method.
+//
+// It respects resolutionOption interface.
+func (o *scopeKeyOption) apply(resolution *resolution) {
+	resolution.scope = o.scope
+}
+
+// InScope delivers a KeyOption that defines the Scope used for all instances
+// defined with AsScoped method. It also uses the Container from which
+// the Scope is opened.
+//
+// Example:
+// scope := genjector.NewScope()
+// defer scope.Close(ctx)
+//
+// instance, err := genjector.NewInstance[ScopedInterface](genjector.InScope(scope))
+//
+// InScope should be only used as a KeyOption for NewInstance method.
+func InScope(scope *Scope) KeyOption {
+	return &scopeKeyOption{
+		scope: scope,
+	}
+}
@@ -0,0 +1,163 @@
+package genjector
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestContainer_NewScope(t *testing.T) {
+	container := NewContainer()
+
+	scope := container.NewScope()
+	if scope.container != container {
+		t.Error("expected scope to be opened from the container")
+	}
+	if scope.closed {
+		t.Error("expected scope to be opened")
+	}
+}
+
+func TestNewScope(t *testing.T) {
+	scope := NewScope()
+	if scope.container != global {
+		t.Error("expected scope to be opened from the global container")
+	}
+}
+
+func TestScope_Close(t *testing.T) {
+	scope := NewContainer().NewScope()
+
+	binding := &scopedBinding{
+		parent: pointerBinding[testStruct]{},
+	}
+
+	_, err := scope.binding(binding)
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+
+	err = scope.Close(context.Background())
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if scope.instances != nil {
+		t.Error("expected instances to be dropped")
+	}
+
+	_, err = scope.binding(binding)
+	if err == nil {
+		t.Error("expected error, got nil")
+	}
+}
+
+func Test_scopedBinding_Instance_noScope(t *testing.T) {
+	binding := &scopedBinding{
+		parent: pointerBinding[testStruct]{},
+	}
+
+	instance, err := binding.Instance(context.Background(), true)
+	if err == nil {
+		t.Error("expected error, got nil")
+	}
+	if instance != nil {
+		t.Errorf(`expected nil, got: %v`, instance)
+	}
+
+	instance, err = binding.Instance(context.Background(), false)
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if !reflect.DeepEqual(instance, &testStruct{}) {
+		t.Errorf(`expected pointer to empty struct, got: %v`, instance)
+	}
+}
+
+func Test_scopedBinding_Instance(t *testing.T) {
+	binding := &scopedBinding{
+		parent: pointerBinding[testStruct]{},
+	}
+
+	container := NewContainer()
+	first := container.NewScope()
+	second := container.NewScope()
+
+	firstContext := context.WithValue(context.Background(), resolutionKey{}, &resolution{
+		scope: first,
+	})
+	secondContext := context.WithValue(context.Background(), resolutionKey{}, &resolution{
+		scope: second,
+	})
+
+	firstInstance, err := binding.Instance(firstContext, true)
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if !reflect.DeepEqual(firstInstance, &testStruct{
+		a: "test",
+		b: 10,
+	}) {
+		t.Errorf(`expected initialized struct, got: %v`, firstInstance)
+	}
+
+	instance, err := binding.Instance(firstContext, true)
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if instance != firstInstance {
+		t.Error("expected the same instance inside the same scope")
+	}
+
+	instance, err = binding.Instance(secondContext, true)
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if instance == firstInstance {
+		t.Error("expected different instances inside different scopes")
+	}
+}
+
+func TestAsScoped(t *testing.T) {
+	result := AsScoped()
+
+	binding, err := result.(*bindingOption).bindingFunc(&valueBinding[int]{})
+	if err != nil {
+		t.Error("unexpected error")
+	}
+	if !reflect.DeepEqual(binding, &scopedBinding{
+		parent: &valueBinding[int]{},
+	}) {
+		t.Error("bindings are different")
+	}
+
+	result.(*bindingOption).bindingFunc = nil
+
+	if !reflect.DeepEqual(result, &bindingOption{
+		keyOption: sameKeyOption{},
+	}) {
+		t.Error("binding options are different")
+	}
+}
+
+func TestInScope(t *testing.T) {
+	container := NewContainer()
+	scope := container.NewScope()
+
+	result := InScope(scope)
+	if result.Container(global) != container {
+		t.Error("expected container of the scope")
+	}
+
+	key := Key{
+		Annotation: "annotation",
+	}
+	if result.Key(key) != key {
+		t.Error("keys are different")
+	}
+
+	resolution := &resolution{}
+	result.(resolutionOption).apply(resolution)
+	if resolution.scope != scope {
+		t.Error("expected scope to be applied")
+	}
+}
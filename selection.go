@@ -0,0 +1,259 @@
+package genjector
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Decoder represents a function that decodes the content of a configuration
+// file into the value, like json.Unmarshal does.
+type Decoder func(data []byte, value interface{}) error
+
+// Configuration represents the content of a configuration file, that defines
+// which named implementation is bound to which interface and annotation.
+//
+// Example:
+//
+//	{
+//	  "bindings": [
+//	    {"interface": "cache", "implementation": "redis"},
+//	    {"interface": "cache", "annotation": "sessions", "implementation": "memory"}
+//	  ]
+//	}
+type Configuration struct {
+	Bindings []ConfigurationBinding `json:"bindings"`
+}
+
+// ConfigurationBinding represents a single selection inside the Configuration.
+// Interface is the name used with Selectable method, while Implementation is
+// the key of the implementation used with InMap method.
+type ConfigurationBinding struct {
+	Interface      string `json:"interface"`
+	Annotation     string `json:"annotation,omitempty"`
+	Implementation string `json:"implementation"`
+}
+
+// RegistryOption represents an option for the Registry, used with NewRegistry method.
+type RegistryOption func(registry *Registry)
+
+// selector is a struct that holds all logic for a single interface
+// defined with Selectable method.
+type selector struct {
+	validate func(container *Container, implementation string) error
+	bind     func(container *Container, annotation string, implementation string) error
+}
+
+// Registry is a struct used for selecting implementations from the Configuration.
+// Implementations are bound with InMap method under string keys, while the Registry
+// knows which names from the Configuration belong to which interfaces.
+type Registry struct {
+	selectors map[string]selector
+	decoder   Decoder
+}
+
+// NewRegistry delivers a new instance of Registry with all interfaces defined
+// with Selectable method. By default, it decodes configuration with json.Unmarshal.
+//
+// Example:
+// registry := genjector.NewRegistry(
+//
+//	genjector.Selectable[CacheInterface]("cache", genjector.AsSingleton()),
+//
+// )
+func NewRegistry(options ...RegistryOption) *Registry {
+	registry := &Registry{
+		selectors: map[string]selector{},
+		decoder:   json.Unmarshal,
+	}
+	for _, option := range options {
+		option(registry)
+	}
+	return registry
+}
+
+// WithDecoder delivers a RegistryOption that replaces json.Unmarshal
+// with a custom Decoder, for example for YAML configuration files.
+//
+// Example:
+// registry := genjector.NewRegistry(genjector.WithDecoder(yaml.Unmarshal))
+func WithDecoder(decoder Decoder) RegistryOption {
+	return func(registry *Registry) {
+		registry.decoder = decoder
+	}
+}
+
+// Selectable delivers a RegistryOption that defines the interface T under the
+// name, so it can be used inside the Configuration. Implementations for T
+// are taken from the map of string-T pairs, defined with InMap method.
+// All instances of BindingOption are used when T is bound, like AsSingleton.
+//
+// Example:
+// err := genjector.Bind[CacheInterface](genjector.InMap[string, CacheInterface]("redis", genjector.AsPointer[CacheInterface, *RedisCache]()))
+// err = genjector.Bind[CacheInterface](genjector.InMap[string, CacheInterface]("memory", genjector.AsPointer[CacheInterface, *MemoryCache]()))
+//
+// registry := genjector.NewRegistry(genjector.Selectable[CacheInterface]("cache"))
+func Selectable[T any](name string, options ...BindingOption) RegistryOption {
+	return func(registry *Registry) {
+		registry.selectors[name] = selector{
+			validate: func(container *Container, implementation string) error {
+				_, err := selectionMember[T](container, implementation)
+				return err
+			},
+			bind: func(container *Container, annotation string, implementation string) error {
+				selected := make([]BindingOption, 0, len(options)+2)
+				selected = append(selected, options...)
+				selected = append(selected, WithAnnotation(annotation), WithContainer(container))
+
+				return Bind[T](&selectionBindingSource[T]{
+					implementation: implementation,
+				}, selected...)
+			},
+		}
+	}
+}
+
+// Load decodes the Configuration from data with the Decoder of the Registry,
+// and executes Apply method with it.
+//
+// Example:
+// data, err := os.ReadFile("genjector.json")
+// err = registry.Load(customContainer, data)
+func (r *Registry) Load(container *Container, data []byte) error {
+	var configuration Configuration
+	err := r.decoder(data, &configuration)
+	if err != nil {
+		return fmt.Errorf("configuration can not be decoded: %w", err)
+	}
+
+	return r.Apply(container, configuration)
+}
+
+// Apply binds all implementations selected in the Configuration inside the
+// Container. It executes Validate method first, and in case of any error,
+// no Binding is defined.
+//
+// Example:
+// err := registry.Apply(customContainer, configuration)
+func (r *Registry) Apply(container *Container, configuration Configuration) error {
+	err := r.Validate(container, configuration)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, binding := range configuration.Bindings {
+		err := r.selectors[binding.Interface].bind(container, binding.Annotation, binding.Implementation)
+		if err != nil {
+			errs = append(errs, fmt.Errorf(`configuration failed for interface "%s": %w`, binding.Interface, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Validate checks that all names inside the Configuration are known: each
+// interface has to be defined with Selectable method, and each implementation
+// has to be bound with InMap method inside the Container. It reports all
+// failures at once, joined together.
+//
+// Example:
+// err := registry.Validate(customContainer, configuration)
+func (r *Registry) Validate(container *Container, configuration Configuration) error {
+	var errs []error
+	for _, binding := range configuration.Bindings {
+		selector, ok := r.selectors[binding.Interface]
+		if !ok {
+			errs = append(errs, fmt.Errorf(`configuration failed for interface "%s": %w`, binding.Interface, ErrUnknownName))
+			continue
+		}
+
+		err := selector.validate(container, binding.Implementation)
+		if err != nil {
+			errs = append(errs, fmt.Errorf(`configuration failed for interface "%s": %w`, binding.Interface, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// selectionMember delivers the Binding stored under the implementation name
+// inside the map of string-T pairs, defined with InMap method. In case the map
+// is defined with conditions, the Binding active inside the Container is used.
+func selectionMember[T any](container *Container, implementation string) (Binding, error) {
+	key := mapKeySource[string, T]{}.Key()
+
+	binding, _, ok := container.binding(key.Generate())
+	if ok {
+		binding, ok = container.active(binding)
+	}
+	if ok {
+		for {
+			wrapping, ok := binding.(wrappingBinding)
+			if !ok {
+				break
+			}
+			binding = wrapping.unwrap()
+		}
+
+		if group, ok := binding.(*mapBinding[string, T]); ok {
+			if member, ok := group.member(container, implementation); ok {
+				return member, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf(`implementation "%s" for key "%s": %w`, implementation, key, ErrUnknownName)
+}
+
+// selectionBinding is a concrete implementation for Binding interface.
+type selectionBinding[T any] struct {
+	implementation string
+}
+
+// Instance delivers the instance of the implementation selected by the name,
+// from the map of string-T pairs inside the Container.
+//
+// It respects Binding interface.
+func (b *selectionBinding[T]) Instance(ctx context.Context, initialize bool) (interface{}, error) {
+	container := resolutionFromContext(ctx).container
+	if container == nil {
+		container = global
+	}
+
+	member, err := selectionMember[T](container, b.implementation)
+	if err != nil {
+		return nil, err
+	}
+
+	return member.Instance(ctx, initialize)
+}
+
+// dependencies delivers the Key of the map of string-T pairs.
+//
+// It respects dependentBinding interface.
+func (b *selectionBinding[T]) dependencies() []Key {
+	return []Key{mapKeySource[string, T]{}.Key()}
+}
+
+// selectionBindingSource is a concrete implementation for BindingSource interface.
+type selectionBindingSource[T any] struct {
+	implementation string
+}
+
+// Binding returns an instance of a new selectionBinding.
+//
+// It respects BindingSource interface.
+func (s *selectionBindingSource[T]) Binding() (Binding, error) {
+	return &selectionBinding[T]{
+		implementation: s.implementation,
+	}, nil
+}
+
+// Key returns the Key for T type.
+//
+// It respects BindingSource interface.
+func (s *selectionBindingSource[T]) Key() Key {
+	return baseKeySource[T]{}.Key()
+}
@@ -0,0 +1,238 @@
+package genjector
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func newSelectionContainer() *Container {
+	container := NewContainer()
+	MustBind[testDependency](InMap[string, testDependency]("first", AsInstance[testDependency](&testDependencyStruct{value: "first"})), WithContainer(container))
+	MustBind[testDependency](InMap[string, testDependency]("second", AsInstance[testDependency](&testDependencyStruct{value: "second"})), WithContainer(container))
+	return container
+}
+
+func TestRegistry_Load(t *testing.T) {
+	container := newSelectionContainer()
+	registry := NewRegistry(Selectable[testDependency]("dependency", AsSingleton()))
+
+	err := registry.Load(container, []byte(`{
+		"bindings": [
+			{"interface": "dependency", "implementation": "second"},
+			{"interface": "dependency", "annotation": "annotation", "implementation": "first"}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+
+	instance, err := NewInstance[testDependency](WithContainer(container))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if instance.Value() != "second" {
+		t.Errorf(`expected "second", got: "%s"`, instance.Value())
+	}
+
+	instance, err = NewInstance[testDependency](WithContainer(container), WithAnnotation("annotation"))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if instance.Value() != "first" {
+		t.Errorf(`expected "first", got: "%s"`, instance.Value())
+	}
+
+	binding, _, _ := container.binding(Key{Value: (*testDependency)(nil)}.Generate())
+	if _, ok := binding.(*singletonBinding); !ok {
+		t.Errorf(`expected singletonBinding, got: %T`, binding)
+	}
+
+	err = registry.Load(container, []byte(`{`))
+	if err == nil || !strings.Contains(err.Error(), "configuration can not be decoded") {
+		t.Errorf(`expected decoding error, got: %v`, err)
+	}
+}
+
+func TestRegistry_Load_decoder(t *testing.T) {
+	container := newSelectionContainer()
+	registry := NewRegistry(
+		Selectable[testDependency]("dependency"),
+		WithDecoder(func(data []byte, value interface{}) error {
+			configuration := value.(*Configuration)
+			configuration.Bindings = append(configuration.Bindings, ConfigurationBinding{
+				Interface:      "dependency",
+				Implementation: string(data),
+			})
+			return nil
+		}),
+	)
+
+	err := registry.Load(container, []byte("first"))
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+
+	instance, err := NewInstance[testDependency](WithContainer(container))
+	if err != nil || instance.Value() != "first" {
+		t.Errorf(`expected "first", got: "%s", %v`, instance.Value(), err)
+	}
+}
+
+func TestRegistry_Validate(t *testing.T) {
+	container := newSelectionContainer()
+	registry := NewRegistry(
+		Selectable[testDependency]("dependency"),
+		Selectable[int]("number"),
+	)
+
+	configuration := Configuration{
+		Bindings: []ConfigurationBinding{
+			{Interface: "dependency", Implementation: "first"},
+			{Interface: "unknown", Implementation: "first"},
+			{Interface: "dependency", Implementation: "third"},
+			{Interface: "number", Implementation: "first"},
+		},
+	}
+
+	err := registry.Validate(container, configuration)
+	if !errors.Is(err, ErrUnknownName) {
+		t.Fatalf(`expected ErrUnknownName, got: %v`, err)
+	}
+
+	for _, expected := range []string{
+		`configuration failed for interface "unknown": name is not registered`,
+		`configuration failed for interface "dependency": implementation "third" for key "map[string]github.com/ompluscator/genjector.testDependency": name is not registered`,
+		`configuration failed for interface "number": implementation "first" for key "map[string]int": name is not registered`,
+	} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf(`expected "%s" inside: %v`, expected, err)
+		}
+	}
+
+	err = registry.Apply(container, configuration)
+	if !errors.Is(err, ErrUnknownName) {
+		t.Errorf(`expected ErrUnknownName, got: %v`, err)
+	}
+	if IsBound[testDependency](WithContainer(container)) {
+		t.Error("expected no binding after failed validation")
+	}
+}
+
+func Test_selectionBinding_Instance(t *testing.T) {
+	container := newSelectionContainer()
+	registry := NewRegistry(Selectable[testDependency]("dependency"))
+
+	err := registry.Apply(container, Configuration{
+		Bindings: []ConfigurationBinding{
+			{Interface: "dependency", Implementation: "first"},
+		},
+	})
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+
+	Unbind[map[string]testDependency](WithContainer(container))
+
+	_, err = NewInstance[testDependency](WithContainer(container))
+	if !errors.Is(err, ErrUnknownName) {
+		t.Errorf(`expected ErrUnknownName, got: %v`, err)
+	}
+
+	err = container.Validate()
+	if !errors.Is(err, ErrUnknownName) {
+		t.Errorf(`expected ErrUnknownName, got: %v`, err)
+	}
+}
+
+func Test_selectionMember_conditional(t *testing.T) {
+	container := newSelectionContainer()
+	MustBind[testDependency](InMap[string, testDependency]("third", AsInstance[testDependency](&testDependencyStruct{value: "third"})), WithContainer(container), WithProfile("test"))
+
+	_, err := selectionMember[testDependency](container, "third")
+	if !errors.Is(err, ErrUnknownName) {
+		t.Errorf(`expected ErrUnknownName, got: %v`, err)
+	}
+
+	member, err := selectionMember[testDependency](container, "second")
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+	instance, err := member.Instance(context.Background(), true)
+	if err != nil || instance.(testDependency).Value() != "second" {
+		t.Errorf(`expected second, got: %v, %v`, instance, err)
+	}
+
+	container.SetActiveProfiles("test")
+	registry := NewRegistry(Selectable[testDependency]("dependency"))
+	err = registry.Apply(container, Configuration{
+		Bindings: []ConfigurationBinding{
+			{Interface: "dependency", Implementation: "third"},
+		},
+	})
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+
+	dependency, err := NewInstance[testDependency](WithContainer(container))
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+	if dependency.Value() != "third" {
+		t.Errorf(`expected third, got: %s`, dependency.Value())
+	}
+}
+
+func TestRegistry_Apply_conditional(t *testing.T) {
+	container := NewContainer()
+	MustBind[testDependency](InMap[string, testDependency]("redis", AsInstance[testDependency](&testDependencyStruct{value: "redis"})), WithContainer(container), WithProfile("prod"))
+	MustBind[testDependency](InMap[string, testDependency]("memory", AsInstance[testDependency](&testDependencyStruct{value: "memory"})), WithContainer(container))
+
+	registry := NewRegistry(Selectable[testDependency]("cache"))
+	configuration := Configuration{
+		Bindings: []ConfigurationBinding{
+			{Interface: "cache", Implementation: "redis"},
+		},
+	}
+
+	err := registry.Validate(container, configuration)
+	if !errors.Is(err, ErrUnknownName) {
+		t.Errorf(`expected ErrUnknownName, got: %v`, err)
+	}
+
+	container.SetActiveProfiles("prod")
+	err = registry.Apply(container, configuration)
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+
+	dependency, err := NewInstance[testDependency](WithContainer(container))
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+	if dependency.Value() != "redis" {
+		t.Errorf(`expected redis, got: %s`, dependency.Value())
+	}
+}
+
+func Test_mapBinding_member(t *testing.T) {
+	binding := &mapBinding[string, int]{
+		previous: &mapBinding[string, int]{
+			key:     "first",
+			current: &instanceBinding[int]{instance: 1},
+		},
+		key:     "first",
+		current: &instanceBinding[int]{instance: 2},
+	}
+
+	member, ok := binding.member(NewContainer(), "first")
+	if !ok || member != binding.current {
+		t.Errorf(`expected latest member, got: %v`, member)
+	}
+
+	_, ok = binding.member(NewContainer(), "second")
+	if ok {
+		t.Error("expected no member")
+	}
+}
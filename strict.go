@@ -0,0 +1,94 @@
+package genjector
+
+// SetStrictMode enables (or disables) the strict mode for the Container. In the strict
+// mode, NewInstance method never uses the fallback Binding, but returns ErrNotBound for
+// every Key that does not have a Binding. Only Lazy and Provider are still delivered
+// without Binding. The strict mode of the Container is used also by all its child containers.
+//
+// Example:
+// customContainer.SetStrictMode(true)
+func (c *Container) SetStrictMode(strict bool) {
+	c.strict.Store(strict)
+}
+
+// SetStrictMode enables (or disables) the strict mode for the standard internal (global)
+// Container, in the same way as SetStrictMode method of the Container.
+//
+// Example:
+// genjector.SetStrictMode(true)
+func SetStrictMode(strict bool) {
+	global.SetStrictMode(strict)
+}
+
+// isStrict checks if the strict mode is enabled for the Container,
+// or for any of its parents.
+func (c *Container) isStrict() bool {
+	for container := c; container != nil; container = container.parent {
+		if container.strict.Load() {
+			return true
+		}
+	}
+	return false
+}
+
+// implicitInstance represents an instance that can be always delivered by
+// the fallback Binding, even in the strict mode, like Lazy and Provider.
+type implicitInstance interface {
+	implicit()
+}
+
+// implicitBinding represents a Binding that can deliver implicitInstance.
+type implicitBinding interface {
+	implicit() bool
+}
+
+// implicit checks if the instance of type S respects implicitInstance interface.
+//
+// It respects implicitBinding interface.
+func (valueBinding[S]) implicit() bool {
+	_, ok := interface{}((*S)(nil)).(implicitInstance)
+	return ok
+}
+
+// isImplicit checks if the Binding can be used in the strict mode,
+// even when it is not defined inside the Container.
+func isImplicit(binding Binding) bool {
+	value, ok := binding.(implicitBinding)
+	return ok && value.implicit()
+}
+
+// strictKeyOption is a concrete implementation for KeyOption interface.
+type strictKeyOption struct{}
+
+// Key returns the same instance of Key struct provided as an argument.
+//
+// It respects KeyOption interface.
+func (strictKeyOption) Key(key Key) Key {
+	return key
+}
+
+// Container returns the same instance of Container struct provided as an argument.
+//
+// It respects KeyOption interface.
+func (strictKeyOption) Container(container *Container) *Container {
+	return container
+}
+
+// apply enables the strict mode for the NewInstance method.
+//
+// It respects resolutionOption interface.
+func (strictKeyOption) apply(resolution *resolution) {
+	resolution.strict = true
+}
+
+// WithStrictMode delivers a KeyOption that enables the strict mode only for a single
+// execution of NewInstance method, as well as for all instances resolved with the
+// context.Context provided to ContextProviderMethod (or Init method with context.Context).
+//
+// Example:
+// instance, err := genjector.NewInstance[StrictInterface](genjector.WithStrictMode())
+//
+// WithStrictMode should be only used as a KeyOption for NewInstance method.
+func WithStrictMode() KeyOption {
+	return strictKeyOption{}
+}
@@ -0,0 +1,98 @@
+package genjector
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestContainer_SetStrictMode(t *testing.T) {
+	parent := NewContainer()
+	child := NewChildContainer(parent)
+	MustBind[string](AsInstance[string]("value"), WithContainer(child))
+
+	_, err := NewInstance[int](WithContainer(child))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+
+	parent.SetStrictMode(true)
+	if !child.isStrict() {
+		t.Error("expected strict mode from parent")
+	}
+
+	_, err = NewInstance[int](WithContainer(child))
+	if !errors.Is(err, ErrNotBound) {
+		t.Errorf(`expected ErrNotBound, got: %v`, err)
+	}
+
+	value, err := NewInstance[string](WithContainer(child))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+	if value != "value" {
+		t.Errorf(`expected value, got: %s`, value)
+	}
+
+	_, err = NewInstance[Lazy[string]](WithContainer(child))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+
+	_, ok, err := NewOptionalInstance[int](WithContainer(child))
+	if err != nil || ok {
+		t.Errorf(`expected missing value, got: %v, %v`, ok, err)
+	}
+
+	parent.SetStrictMode(false)
+	_, err = NewInstance[int](WithContainer(child))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+}
+
+func TestWithStrictMode(t *testing.T) {
+	inner := NewContainer()
+	MustBind[string](AsContextProvider[string](func(ctx context.Context) (string, error) {
+		_, err := NewInstanceContext[int](ctx)
+		return "value", err
+	}), WithContainer(inner))
+	MustBind[bool](AsConstructor1[bool](func(lazy Lazy[int]) (bool, error) {
+		_, err := lazy.Get()
+		return true, err
+	}), WithContainer(inner))
+
+	_, err := NewInstance[string](WithContainer(inner))
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+
+	_, err = NewInstance[string](WithContainer(inner), WithStrictMode())
+	if !errors.Is(err, ErrNotBound) {
+		t.Errorf(`expected ErrNotBound, got: %v`, err)
+	}
+
+	_, err = NewInstance[bool](WithContainer(inner), WithStrictMode())
+	if !errors.Is(err, ErrNotBound) {
+		t.Errorf(`expected ErrNotBound, got: %v`, err)
+	}
+
+	if inner.isStrict() {
+		t.Error("expected container without strict mode")
+	}
+}
+
+func Test_isImplicit(t *testing.T) {
+	if isImplicit(valueBinding[int]{}) {
+		t.Error("expected int not to be implicit")
+	}
+	if !isImplicit(valueBinding[Lazy[int]]{}) {
+		t.Error("expected Lazy to be implicit")
+	}
+	if !isImplicit(valueBinding[Provider[int]]{}) {
+		t.Error("expected Provider to be implicit")
+	}
+	if isImplicit(pointerBinding[Lazy[int]]{}) {
+		t.Error("expected pointer Binding not to be implicit")
+	}
+}
@@ -0,0 +1,94 @@
+package genjector
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// tracingDepth is the average number of unfinished resolutions per goroutine,
+// after which resolutions made without the context.Context are suspected to
+// be a part of the cycle, so they start linking to each other.
+const tracingDepth = 64
+
+// traces holds the state for linking resolutions made without the
+// context.Context, like inside Init method without it, to the resolutions
+// in progress on the same goroutine.
+var traces struct {
+	running atomic.Int64
+	linked  atomic.Int64
+	chains  sync.Map
+}
+
+// tracing checks if resolutions should be linked, which is the case while
+// some singleton is in the process of construction, or when there are too
+// many unfinished resolutions, which indicates the cycle without the context.Context.
+func tracing() bool {
+	if traces.linked.Load() > 0 {
+		return true
+	}
+
+	running := traces.running.Load()
+	return running > tracingDepth && running > tracingDepth*int64(runtime.NumGoroutine())
+}
+
+// tracedResolution delivers the last linked resolution in progress on
+// the current goroutine, or nil in case there is no such.
+func tracedResolution() *resolution {
+	if value, ok := traces.chains.Load(goroutineID()); ok {
+		return value.(*resolution)
+	}
+	return nil
+}
+
+// trace holds the state of a single resolution in the process of tracing.
+type trace struct {
+	running   bool
+	linked    bool
+	goroutine uint64
+	previous  interface{}
+}
+
+// enterTrace marks the resolution as unfinished, and links it in case
+// tracing is needed.
+func enterTrace(resolution *resolution) trace {
+	traces.running.Add(1)
+	if !tracing() {
+		return trace{
+			running: true,
+		}
+	}
+
+	result := linkTrace(resolution)
+	result.running = true
+	return result
+}
+
+// linkTrace links the resolution to the current goroutine, so resolutions
+// made without the context.Context can continue its chain.
+func linkTrace(resolution *resolution) trace {
+	traces.linked.Add(1)
+	goroutine := goroutineID()
+	previous, _ := traces.chains.Swap(goroutine, resolution)
+
+	return trace{
+		linked:    true,
+		goroutine: goroutine,
+		previous:  previous,
+	}
+}
+
+// leave restores the state from the moment before the resolution.
+func (t trace) leave() {
+	if t.linked {
+		if t.previous != nil {
+			traces.chains.Store(t.goroutine, t.previous)
+		} else {
+			traces.chains.Delete(t.goroutine)
+		}
+		traces.linked.Add(-1)
+	}
+	if t.running {
+		traces.running.Add(-1)
+	}
+}
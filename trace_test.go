@@ -0,0 +1,83 @@
+package genjector
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_linkTrace(t *testing.T) {
+	if tracedResolution() != nil {
+		t.Fatal("expected no linked resolution")
+	}
+
+	first := &resolution{key: Key{Value: (*int)(nil)}}
+	outer := linkTrace(first)
+	if !tracing() {
+		t.Error("expected tracing")
+	}
+
+	second := &resolution{key: Key{Value: (*string)(nil)}}
+	inner := linkTrace(second)
+	if linked := tracedResolution(); linked != second {
+		t.Errorf(`expected the inner resolution, got: %v`, linked)
+	}
+
+	inner.leave()
+	if linked := tracedResolution(); linked != first {
+		t.Errorf(`expected the outer resolution, got: %v`, linked)
+	}
+
+	outer.leave()
+	if tracedResolution() != nil {
+		t.Error("expected no linked resolution")
+	}
+}
+
+type testTraceFirst struct {
+	second *testTraceSecond
+}
+
+func (s *testTraceFirst) Init() error {
+	var err error
+	s.second, err = NewInstance[*testTraceSecond](WithContainer(testTraceContainer))
+	return err
+}
+
+type testTraceSecond struct {
+	first *testTraceFirst
+}
+
+func (s *testTraceSecond) Init() error {
+	var err error
+	s.first, err = NewInstance[*testTraceFirst](WithContainer(testTraceContainer))
+	return err
+}
+
+var testTraceContainer = NewContainer()
+
+func TestNewInstance_cycleWithoutContext(t *testing.T) {
+	MustBind[*testTraceFirst](AsPointer[*testTraceFirst, *testTraceFirst](), WithContainer(testTraceContainer))
+	MustBind[*testTraceSecond](AsPointer[*testTraceSecond, *testTraceSecond](), WithContainer(testTraceContainer))
+
+	_, err := NewInstanceContext[*testTraceFirst](context.Background(), WithContainer(testTraceContainer))
+
+	var cycleErr *CycleError
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf(`expected CycleError, got: %v`, err)
+	}
+	if err != error(cycleErr) {
+		t.Errorf(`expected unwrapped CycleError, got: %v`, err)
+	}
+
+	first := Key{Value: (*(*testTraceFirst))(nil)}
+	second := Key{Value: (*(*testTraceSecond))(nil)}
+	if !reflect.DeepEqual(cycleErr.Path, []Key{first, second, first}) && !reflect.DeepEqual(cycleErr.Path, []Key{second, first, second}) {
+		t.Errorf(`expected complete path, got: %v`, cycleErr.Path)
+	}
+
+	if traces.running.Load() != 0 || traces.linked.Load() != 0 {
+		t.Errorf(`expected finished tracing, got: %d running and %d linked`, traces.running.Load(), traces.linked.Load())
+	}
+}
@@ -0,0 +1,122 @@
+package genjector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// keys delivers all keys that can be used for NewInstance method with
+// the Container, including the ones defined in parent Container. Keys are
+// sorted by their names.
+func (c *Container) keys() []Key {
+	var keys []Key
+	visited := map[interface{}]bool{}
+	for container := c; container != nil; container = container.parent {
+		for generated := range container.snapshot() {
+			if visited[generated] {
+				continue
+			}
+			visited[generated] = true
+			keys = append(keys, keyFromGenerated(generated))
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+	return keys
+}
+
+// Validate checks all Binding instances that can be used with the Container,
+// including the ones from parent Container, by resolving an instance for each
+// of them. It reports all failures at once, like missing dependencies, invalid
+// types and errors from provider methods, joined together. Keys without any
+// active Binding, defined with WithCondition or WithProfile methods, are skipped.
+//
+// Validation executes all provider methods (and Init methods), but it does not
+// store any instance: singletons that are not created yet are made only for
+// validation, and scoped instances are made inside a temporary Scope. At the end
+// of validation, all instances made by it that respect io.Closer (or Disposable)
+// interface are released. Instances made inside NewInstance method executed
+// without the context.Context, like inside Init method without it, are not tracked.
+//
+// In case the ProviderMethod (or Init method) panics, like when it executes
+// MustNewInstance method for a Key that is not bound, the panic is reported
+// as the error for that Key, and validation continues with the next one.
+//
+// Example:
+// err := customContainer.Validate()
+func (c *Container) Validate() error {
+	ctx := context.Background()
+	scope := c.NewScope()
+
+	var validation disposer
+
+	var errs []error
+	for _, key := range c.keys() {
+		binding, _, _ := c.binding(key.Generate())
+		if _, ok := c.active(binding); !ok {
+			continue
+		}
+
+		child := &resolutionContext{
+			Context: ctx,
+			resolution: resolution{
+				container:  c,
+				scope:      scope,
+				key:        key,
+				validation: &validation,
+			},
+		}
+
+		err := validate(child)
+		if err != nil {
+			errs = append(errs, fmt.Errorf(`validation failed for key "%s": %w`, key, err))
+		}
+	}
+
+	err := validation.dispose(ctx)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	err = scope.Close(ctx)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// validate resolves the instance for the resolution, by converting
+// a panic into an error.
+func validate(ctx *resolutionContext) (err error) {
+	defer func() {
+		recovered := recover()
+		if recovered == nil {
+			return
+		}
+
+		if recoveredErr, ok := recovered.(error); ok {
+			err = fmt.Errorf("instance can not be provided, panic: %w", recoveredErr)
+		} else {
+			err = fmt.Errorf("instance can not be provided, panic: %v", recovered)
+		}
+	}()
+
+	_, err = resolve(ctx, func() (Binding, error) {
+		return nil, ErrNotBound
+	})
+	return err
+}
+
+// Validate checks all Binding instances from the standard internal (global)
+// Container, in the same way as Validate method of the Container.
+//
+// Example:
+// err := genjector.Validate()
+func Validate() error {
+	return global.Validate()
+}
@@ -0,0 +1,157 @@
+package genjector
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestContainer_keys(t *testing.T) {
+	parent := newTestContainer(map[interface{}]Binding{
+		(*int)(nil):    nil,
+		(*string)(nil): nil,
+	})
+	child := NewChildContainer(parent)
+	child.bindings.Store(&map[interface{}]Binding{
+		(*int)(nil): nil,
+		[2]interface{}{"annotation", (*bool)(nil)}: nil,
+	})
+
+	keys := child.keys()
+	if !reflect.DeepEqual(keys, []Key{
+		{Value: (*bool)(nil), Annotation: "annotation"},
+		{Value: (*int)(nil)},
+		{Value: (*string)(nil)},
+	}) {
+		t.Errorf(`expected concrete keys, got: %v`, keys)
+	}
+}
+
+func TestContainer_Validate_success(t *testing.T) {
+	inner := NewContainer()
+	MustBind[int](AsInstance[int](10), WithContainer(inner))
+	MustBind[*testStruct](AsPointer[*testStruct, *testStruct](), AsScoped(), WithContainer(inner))
+	MustBind[string](AsConstructor1[string](func(value int) (string, error) {
+		return "value", nil
+	}), AsSingleton(), WithContainer(inner))
+
+	err := inner.Validate()
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+}
+
+func TestContainer_Validate_failure(t *testing.T) {
+	parent := NewContainer()
+	MustBind[int](AsContextProvider[int](func(ctx context.Context) (int, error) {
+		return 0, errors.New("provider error")
+	}), WithContainer(parent))
+
+	child := NewChildContainer(parent)
+	MustBind[string](AsConstructor1[string](func(value testDependency) (string, error) {
+		return value.Value(), nil
+	}), WithContainer(child))
+	MustBind[*testStruct](AsPointer[*testStruct, *testStruct](), WithContainer(child))
+	MustBind[*testFailingStruct](AsPointer[*testFailingStruct, *testFailingStruct](), WithContainer(child))
+
+	err := child.Validate()
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	if !errors.Is(err, errTestInit) {
+		t.Errorf(`expected init error, got: %v`, err)
+	}
+
+	for _, expected := range []string{"provider error", "testDependency", "testFailingStruct"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf(`expected error to contain "%s", got: %v`, expected, err)
+		}
+	}
+
+	if len(err.(interface{ Unwrap() []error }).Unwrap()) != 3 {
+		t.Errorf(`expected 3 errors, got: %v`, err)
+	}
+}
+
+func TestContainer_Validate_panic(t *testing.T) {
+	inner := NewContainer()
+	MustBind[string](AsContextProvider[string](func(ctx context.Context) (string, error) {
+		return MustNewInstance[testDependency](WithContainer(inner)).Value(), nil
+	}), WithContainer(inner))
+	MustBind[bool](AsContextProvider[bool](func(ctx context.Context) (bool, error) {
+		panic("value")
+	}), WithContainer(inner))
+	MustBind[int](AsInstance[int](10), WithContainer(inner))
+
+	err := inner.Validate()
+	if !errors.Is(err, ErrNotBound) {
+		t.Errorf(`expected ErrNotBound, got: %v`, err)
+	}
+
+	for _, expected := range []string{
+		`validation failed for key "string": instance can not be provided, panic: binding is not defined`,
+		`validation failed for key "bool": instance can not be provided, panic: value`,
+	} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf(`expected "%s" inside: %v`, expected, err)
+		}
+	}
+	if strings.Contains(err.Error(), `"int"`) {
+		t.Errorf(`expected no error for valid key, got: %v`, err)
+	}
+}
+
+func TestContainer_Validate_dispose(t *testing.T) {
+	var closed []string
+	counter := 0
+	inner := NewContainer()
+	MustBind[*testCloser](AsContextProvider[*testCloser](func(ctx context.Context) (*testCloser, error) {
+		return &testCloser{name: "transient", closed: &closed}, nil
+	}), WithContainer(inner))
+	MustBind[*testCloser](AsContextProvider[*testCloser](func(ctx context.Context) (*testCloser, error) {
+		counter++
+		return &testCloser{name: "singleton", closed: &closed}, nil
+	}), AsSingleton(), WithContainer(inner), WithAnnotation("singleton"))
+
+	err := inner.Validate()
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+	sort.Strings(closed)
+	if !reflect.DeepEqual(closed, []string{"singleton", "transient"}) {
+		t.Errorf(`expected closed instances, got: %v`, closed)
+	}
+
+	closed = nil
+	_, err = NewInstance[*testCloser](WithContainer(inner), WithAnnotation("singleton"))
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+	if counter != 2 {
+		t.Errorf(`expected new singleton after validation, got: %d calls`, counter)
+	}
+
+	err = inner.Close(context.Background())
+	if err != nil {
+		t.Fatalf(`expected nil, got: %v`, err)
+	}
+	if !reflect.DeepEqual(closed, []string{"singleton"}) {
+		t.Errorf(`expected only the stored singleton closed, got: %v`, closed)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	Clean()
+	defer Clean()
+
+	MustBind[int](AsInstance[int](10))
+
+	err := Validate()
+	if err != nil {
+		t.Errorf(`expected nil, got: %v`, err)
+	}
+}